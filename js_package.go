@@ -0,0 +1,171 @@
+package main
+
+import (
+	"strings"
+)
+
+// jsStripComments membuang komentar // dan /* */ di luar string/template literal.
+// Newline dipertahankan supaya nomor baris tetap sama.
+func jsStripComments(src string) string {
+	var b strings.Builder
+	b.Grow(len(src))
+	for i := 0; i < len(src); i++ {
+		c := src[i]
+		switch {
+		case c == '/' && i+1 < len(src) && src[i+1] == '/':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+			if i < len(src) {
+				b.WriteByte('\n')
+			}
+		case c == '/' && i+1 < len(src) && src[i+1] == '*':
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				end = len(src) - i - 2
+			}
+			b.WriteString(strings.Repeat("\n", strings.Count(src[i:i+2+end], "\n")))
+			i += end + 3
+		case c == '"' || c == '\'' || c == '`':
+			j := i + 1
+			for j < len(src) && src[j] != c {
+				if src[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			j = min(j, len(src)-1)
+			b.WriteString(src[i : j+1])
+			i = j
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// ================= JS literal evaluator =================
+
+type jsObject []jsProp
+
+type jsProp struct {
+	Key string
+	Val any
+}
+
+type jsArray []any
+
+type jsRaw string // ekspresi yang tidak dievaluasi
+
+// jsValue mengevaluasi literal object/array/string JS/TS secara dangkal.
+func jsValue(s string) any {
+	s = strings.TrimSpace(s)
+	s = strings.TrimSuffix(strings.TrimSpace(strings.TrimSuffix(s, " as const")), ";")
+	switch s {
+	case "":
+		return nil
+	case "true":
+		return true
+	case "false":
+		return false
+	case "null", "undefined":
+		return nil
+	}
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'' || s[0] == '`') && s[len(s)-1] == s[0] &&
+		!strings.ContainsRune(s[1:len(s)-1], rune(s[0])) {
+		return s[1 : len(s)-1]
+	}
+	switch s[0] {
+	case '{':
+		if matchClose(s, 0) != len(s)-1 {
+			break
+		}
+		obj := jsObject{}
+		for _, p := range splitTopLevel(s[1:len(s)-1], ',') {
+			if strings.HasPrefix(p, "...") {
+				continue
+			}
+			kv := splitTopLevel(p, ':')
+			key := strings.TrimSpace(kv[0])
+			if len(kv) == 1 {
+				// shorthand `{ sequelize }` atau method `foo() {}`
+				if i := strings.IndexByte(key, '('); i > 0 {
+					obj = append(obj, jsProp{Key: strings.TrimSpace(key[:i]), Val: jsRaw(p)})
+				} else {
+					obj = append(obj, jsProp{Key: key, Val: jsRaw(key)})
+				}
+				continue
+			}
+			key = strings.Trim(key, "\"'`[]")
+			obj = append(obj, jsProp{Key: key, Val: jsValue(strings.Join(kv[1:], ":"))})
+		}
+		return obj
+	case '[':
+		if matchClose(s, 0) != len(s)-1 {
+			break
+		}
+		arr := jsArray{}
+		for _, p := range splitTopLevel(s[1:len(s)-1], ',') {
+			arr = append(arr, jsValue(p))
+		}
+		return arr
+	}
+	return jsRaw(s)
+}
+
+// jsString mengubah hasil evaluasi menjadi teks ringkas.
+func jsString(v any) string {
+	switch x := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return x
+	case bool:
+		if x {
+			return "true"
+		}
+		return "false"
+	case jsRaw:
+		return string(x)
+	case jsArray:
+		var parts []string
+		for _, e := range x {
+			parts = append(parts, jsString(e))
+		}
+		return "[" + strings.Join(parts, ", ") + "]"
+	case jsObject:
+		var parts []string
+		for _, e := range x {
+			parts = append(parts, e.Key+": "+jsString(e.Val))
+		}
+		return "{" + strings.Join(parts, ", ") + "}"
+	}
+	return ""
+}
+
+func (o jsObject) get(key string) (any, bool) {
+	for _, p := range o {
+		if p.Key == key {
+			return p.Val, true
+		}
+	}
+	return nil, false
+}
+
+func (o jsObject) str(key string) string {
+	v, _ := o.get(key)
+	return jsString(v)
+}
+
+// jsCallArgs mengambil argumen pemanggilan yang kurung bukanya di src[open].
+func jsCallArgs(src string, open int) ([]string, int) {
+	end := matchClose(src, open)
+	if end < 0 {
+		return nil, -1
+	}
+	return splitTopLevel(src[open+1:end], ','), end
+}
+
+func lineAt(src string, off int) int {
+	return strings.Count(src[:min(off, len(src))], "\n") + 1
+}
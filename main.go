@@ -10,6 +10,10 @@ import (
 )
 
 func main() {
+	// subcommand: ctxgen query symbol <name>
+	if len(os.Args) > 1 && os.Args[1] == "query" {
+		os.Exit(runQuery(os.Args[2:]))
+	}
 	flag.Parse()
 
 	abs, _ := filepath.Abs(*flagRoot)
@@ -33,16 +37,29 @@ func main() {
 
 	// Go
 	m.Go = readGoModule(abs)
+	if pkgs := readGoPackages(abs); len(pkgs) > 0 {
+		if m.Go == nil {
+			m.Go = &GoInfo{}
+		}
+		m.Go.Packages = pkgs
+	}
 
 	// Python / Rust / Java / .NET / Ruby / Dart / Swift
 	m.Python = readPython(abs)
+	m.PythonOutline = readPythonOutline(abs)
 	m.Rust = readRust(abs)
 	m.Java = readJava(abs)
-	m.DotNet = readDotNet(abs)
+	m.DotNet = readCSharp(abs, readDotNet(abs))
 	m.Ruby = readRuby(abs)
 	m.Dart = readDart(abs)
 	m.Swift = readSwift(abs)
 
+	// Lockfile: versi resolved per ekosistem
+	readLockfiles(abs, m)
+
+	// Monorepo: workspace npm/yarn/pnpm, go.work, Cargo, Maven, Gradle, Composer
+	m.Workspaces = readWorkspaces(abs, m)
+
 	// ENV keys
 	m.EnvKeys = listEnvKeys(abs)
 
@@ -53,6 +70,48 @@ func main() {
 	m.Routes = routes
 	m.Migrations = migrations
 	m.Seeders = seeders
+	m.DatabaseSchema = readLaravelSchema(abs, migrations, *flagERDiagram)
+
+	// SQL migration / DDL files
+	m.SQLSchema = readSQLSchema(abs, *flagERDiagram)
+
+	// ORM data models (Prisma/TypeORM/Sequelize/Drizzle)
+	m.DataModels = readDataModels(abs)
+
+	// TS/JS module outline
+	m.JSOutline = readJSOutline(abs)
+
+	// GraphQL (SDL + code-first)
+	m.GraphQL = readGraphQL(abs)
+
+	// Django
+	m.Django = readDjango(abs)
+	if m.Django != nil && m.Framework == "" {
+		m.Framework = "django"
+	}
+
+	// Rails
+	m.Rails = readRails(abs)
+	if m.Rails != nil {
+		if m.Framework == "" {
+			m.Framework = "rails"
+		}
+		if m.DatabaseSchema == nil {
+			m.DatabaseSchema = readRailsSchema(abs, *flagERDiagram)
+		}
+	}
+
+	// Java/Kotlin outline + Spring
+	m.JVMOutline, m.Spring = readJVM(abs)
+	if m.Spring != nil && m.Framework == "" {
+		m.Framework = "spring"
+	}
+
+	// Dependency graph (setelah semua outline terisi)
+	m.DependencyGraph = readDepGraph(abs, m, *flagDepGraph)
+
+	// Symbol index dari semua outline
+	m.Symbols = buildSymbols(m)
 
 	// Files TOC
 	if *flagIncludeFiles {
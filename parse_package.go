@@ -2,7 +2,6 @@ package main
 
 import (
 	"bufio"
-	"bytes"
 	"compress/gzip"
 	"crypto/sha1"
 	"encoding/hex"
@@ -15,92 +14,6 @@ import (
 	"strings"
 )
 
-func parseTomlLight(full string, max int) map[string]any {
-	out := map[string]any{}
-	b, err := os.ReadFile(full)
-	if err != nil {
-		return out
-	}
-	if len(b) > max {
-		b = b[:max]
-	}
-	section := ""
-	sc := bufio.NewScanner(bytes.NewReader(b))
-	for sc.Scan() {
-		line := strings.TrimSpace(sc.Text())
-		if line == "" || strings.HasPrefix(line, "#") {
-			continue
-		}
-		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
-			section = strings.TrimSpace(strings.Trim(line, "[]"))
-			if _, ok := out[section]; !ok {
-				out[section] = map[string]any{}
-			}
-			continue
-		}
-		if i := strings.IndexByte(line, '='); i > 0 {
-			k := strings.TrimSpace(line[:i])
-			v := strings.TrimSpace(line[i+1:])
-			v = strings.Trim(v, `"'`)
-			if section == "" {
-				out[k] = v
-			} else {
-				m := out[section].(map[string]any)
-				m[k] = v
-			}
-		}
-	}
-	return out
-}
-
-func parseYAMLLight(full string, max int) map[string]any {
-	out := map[string]any{}
-	b, err := os.ReadFile(full)
-	if err != nil {
-		return out
-	}
-	if len(b) > max {
-		b = b[:max]
-	}
-	type stackFrame struct {
-		indent int
-		m      map[string]any
-	}
-	root := map[string]any{}
-	stack := []stackFrame{{indent: -1, m: root}}
-	lines := bytes.Split(b, []byte("\n"))
-	for _, raw := range lines {
-		line := string(raw)
-		if strings.TrimSpace(line) == "" || strings.HasPrefix(strings.TrimSpace(line), "#") {
-			continue
-		}
-		indent := 0
-		for i := 0; i < len(line) && line[i] == ' '; i++ {
-			indent++
-		}
-		line = strings.TrimSpace(line)
-		if !strings.Contains(line, ":") {
-			continue
-		}
-		parts := strings.SplitN(line, ":", 2)
-		k := strings.TrimSpace(parts[0])
-		v := strings.TrimSpace(parts[1])
-		v = strings.Trim(v, `"'`)
-		for len(stack) > 0 && indent <= stack[len(stack)-1].indent {
-			stack = stack[:len(stack)-1]
-		}
-		cur := stack[len(stack)-1].m
-		if v == "" {
-			child := map[string]any{}
-			cur[k] = child
-			stack = append(stack, stackFrame{indent: indent, m: child})
-		} else {
-			cur[k] = v
-		}
-	}
-	return root
-}
-
 func writeNDJSON(root string, m *Manifest, outPath string, csvExt string, withSHA1 bool) error {
 	allow := make(map[string]bool)
 	for _, e := range strings.Split(csvExt, ",") {
@@ -215,6 +128,11 @@ func writeNDJSON(root string, m *Manifest, outPath string, csvExt string, withSH
 	if err := enc.Encode(&toc); err != nil {
 		return err
 	}
+	for _, s := range m.Symbols {
+		if err := enc.Encode(&NDJSONSymbol{Type: "symbol", Symbol: s}); err != nil {
+			return err
+		}
+	}
 
 	buf := make([]byte, 0, 64*1024)
 	for _, f := range files {
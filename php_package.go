@@ -0,0 +1,879 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"slices"
+	"strings"
+)
+
+// ================= PHP tokenizer =================
+
+type phpTok struct {
+	kind byte // n=name v=variable s=string d=number p=punct
+	val  string
+	pos  int // offset awal di source
+	end  int // offset akhir di source
+	line int
+}
+
+var phpPuncts = []string{
+	"<=>", "**=", "...", "<<=", ">>=", "===", "!==", "??=", "?->",
+	"#[", "->", "=>", "::", "==", "!=", "<>", "<=", ">=", "&&", "||", "??", "++", "--",
+	"+=", "-=", "*=", "/=", ".=", "%=", "&=", "|=", "^=", "<<", ">>", "**",
+}
+
+func isPHPNameByte(c byte) bool {
+	return c == '_' || c >= 0x80 || (c|0x20 >= 'a' && c|0x20 <= 'z') || (c >= '0' && c <= '9')
+}
+
+// lexPHP memecah source PHP menjadi token; komentar, whitespace dan inline HTML dibuang.
+func lexPHP(src []byte) []phpTok {
+	var toks []phpTok
+	line := 1
+	n := len(src)
+	i := 0
+	inPHP := false
+	adv := func(to int) {
+		for k := i; k < to && k < n; k++ {
+			if src[k] == '\n' {
+				line++
+			}
+		}
+		i = to
+	}
+	emit := func(kind byte, val string, start, stop int) {
+		l := line
+		toks = append(toks, phpTok{kind: kind, val: val, pos: start, end: stop, line: l})
+	}
+	for i < n {
+		if !inPHP {
+			j := bytes.Index(src[i:], []byte("<?"))
+			if j < 0 {
+				break
+			}
+			adv(i + j + 2)
+			if bytes.HasPrefix(src[i:], []byte("php")) {
+				adv(i + 3)
+			} else if i < n && src[i] == '=' {
+				adv(i + 1)
+			}
+			inPHP = true
+			continue
+		}
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			adv(i + 1)
+		case c == '?' && i+1 < n && src[i+1] == '>':
+			adv(i + 2)
+			inPHP = false
+			emit('p', ";", i, i)
+		case c == '#' && i+1 < n && src[i+1] == '[':
+			emit('p', "#[", i, i+2)
+			adv(i + 2)
+		case c == '#' || (c == '/' && i+1 < n && src[i+1] == '/'):
+			j := i
+			for j < n && src[j] != '\n' {
+				if src[j] == '?' && j+1 < n && src[j+1] == '>' {
+					break
+				}
+				j++
+			}
+			adv(j)
+		case c == '/' && i+1 < n && src[i+1] == '*':
+			j := bytes.Index(src[i+2:], []byte("*/"))
+			if j < 0 {
+				adv(n)
+			} else {
+				adv(i + 2 + j + 2)
+			}
+		case c == '\'' || c == '"' || c == '`':
+			j := i + 1
+			for j < n && src[j] != c {
+				if src[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			stop := min(j+1, n)
+			emit('s', unescapePHP(string(src[i+1:min(j, n)]), c), i, stop)
+			adv(stop)
+		case c == '<' && bytes.HasPrefix(src[i:], []byte("<<<")):
+			start := i
+			j := i + 3
+			for j < n && (src[j] == ' ' || src[j] == '\t') {
+				j++
+			}
+			k := j
+			for k < n && src[k] != '\n' {
+				k++
+			}
+			label := strings.Trim(strings.TrimSpace(string(src[j:k])), `'"`)
+			body := k + 1
+			stop := n
+			contentEnd := n
+			for p := body; p < n; {
+				e := p
+				for e < n && src[e] != '\n' {
+					e++
+				}
+				ln := strings.TrimLeft(string(src[p:e]), " \t")
+				if strings.HasPrefix(ln, label) && (len(ln) == len(label) || !isPHPNameByte(ln[len(label)])) {
+					contentEnd = max(p-1, body)
+					stop = e - len(ln) + len(label)
+					break
+				}
+				p = e + 1
+			}
+			emit('s', string(src[min(body, n):min(contentEnd, n)]), start, stop)
+			adv(stop)
+		case c == '$' && i+1 < n && isPHPNameByte(src[i+1]) && !(src[i+1] >= '0' && src[i+1] <= '9'):
+			j := i + 1
+			for j < n && isPHPNameByte(src[j]) {
+				j++
+			}
+			emit('v', string(src[i+1:j]), i, j)
+			adv(j)
+		case c >= '0' && c <= '9':
+			j := i + 1
+			for j < n && (isPHPNameByte(src[j]) || src[j] == '.') {
+				j++
+			}
+			emit('d', string(src[i:j]), i, j)
+			adv(j)
+		case c == '\\' || (isPHPNameByte(c) && !(c >= '0' && c <= '9')):
+			j := i
+			for j < n && (isPHPNameByte(src[j]) || (src[j] == '\\' && j+1 < n && isPHPNameByte(src[j+1]))) {
+				j++
+			}
+			if j == i {
+				j = i + 1
+			}
+			emit('n', string(src[i:j]), i, j)
+			adv(j)
+		default:
+			val := string(c)
+			for _, p := range phpPuncts {
+				if bytes.HasPrefix(src[i:], []byte(p)) {
+					val = p
+					break
+				}
+			}
+			emit('p', val, i, i+len(val))
+			adv(i + len(val))
+		}
+	}
+	return toks
+}
+
+func unescapePHP(s string, quote byte) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	if quote == '\'' {
+		return strings.NewReplacer(`\\`, `\`, `\'`, `'`).Replace(s)
+	}
+	return strings.NewReplacer(`\\`, `\`, `\"`, `"`, `\n`, "\n", `\t`, "\t", `\$`, `$`).Replace(s)
+}
+
+// ================= PHP outline =================
+
+// phpOutline menyimpan hasil outline beserta token, supaya detektor lain
+// (Eloquent, migration, validation) bisa membaca body method/default property.
+type phpOutline struct {
+	src    []byte
+	toks   []phpTok
+	file   PHPClassFile
+	bodies map[string][2]int // method -> range token body (tanpa kurung kurawal)
+	props  map[string][2]int // property -> range token default value
+}
+
+func parsePHP(full string) PHPClassFile {
+	b, _ := os.ReadFile(full)
+	return outlinePHP(b).file
+}
+
+func readPHPOutline(full string) *phpOutline {
+	b, err := os.ReadFile(full)
+	if err != nil {
+		return nil
+	}
+	return outlinePHP(b)
+}
+
+var phpClassModifiers = map[string]bool{"abstract": true, "final": true, "readonly": true}
+var phpMemberModifiers = map[string]bool{
+	"public": true, "protected": true, "private": true, "static": true,
+	"abstract": true, "final": true, "readonly": true, "var": true,
+}
+
+func outlinePHP(src []byte) *phpOutline {
+	o := &phpOutline{src: src, toks: lexPHP(src), bodies: map[string][2]int{}, props: map[string][2]int{}}
+	toks := o.toks
+	pc := &o.file
+	depth := 0
+	nsDepth := 0 // namespace dengan kurung kurawal
+	for i := 0; i < len(toks); i++ {
+		t := toks[i]
+		if t.kind == 'p' {
+			switch t.val {
+			case "{":
+				depth++
+			case "}":
+				depth--
+			case "#[":
+				i = o.skipBalanced(i, "#[", "]")
+			}
+			continue
+		}
+		if t.kind != 'n' || depth > nsDepth {
+			continue
+		}
+		kw := strings.ToLower(t.val)
+		prev := phpPrevVal(toks, i)
+		switch kw {
+		case "namespace":
+			if i+1 < len(toks) && toks[i+1].kind == 'n' && prev != "::" {
+				pc.Namespace = toks[i+1].val
+				if i+2 < len(toks) && toks[i+2].val == "{" {
+					nsDepth = depth + 1
+				}
+				i++
+			}
+		case "use":
+			// closure `function () use ($x)` bukan import
+			if i+1 < len(toks) && toks[i+1].val != "(" {
+				i = o.useImports(i + 1)
+			}
+		case "class", "interface", "trait", "enum":
+			if prev == "::" || prev == "new" || pc.Class != "" {
+				continue
+			}
+			if i+1 >= len(toks) || toks[i+1].kind != 'n' {
+				continue
+			}
+			if kw == "enum" && (i+2 >= len(toks) || (toks[i+2].val != "{" && toks[i+2].val != ":" && toks[i+2].val != "implements")) {
+				continue
+			}
+			for k := i - 1; k >= 0 && toks[k].kind == 'n' && phpClassModifiers[strings.ToLower(toks[k].val)]; k-- {
+				pc.Modifiers = append([]string{strings.ToLower(toks[k].val)}, pc.Modifiers...)
+			}
+			pc.Kind = kw
+			pc.Class = toks[i+1].val
+			pc.Line = t.line
+			i = o.classBody(i + 2)
+		}
+	}
+	return o
+}
+
+func phpPrevVal(toks []phpTok, i int) string {
+	if i == 0 {
+		return ""
+	}
+	return strings.ToLower(toks[i-1].val)
+}
+
+// skipBalanced mengembalikan index token penutup untuk pembuka di toks[i].
+func (o *phpOutline) skipBalanced(i int, open, close string) int {
+	depth := 0
+	for k := i; k < len(o.toks); k++ {
+		v := o.toks[k].val
+		if o.toks[k].kind != 'p' {
+			continue
+		}
+		switch {
+		case v == open || (open == "#[" && v == "["):
+			depth++
+		case v == close:
+			depth--
+			if depth == 0 {
+				return k
+			}
+		}
+	}
+	return len(o.toks) - 1
+}
+
+// text mengembalikan source asli token a..b (inklusif), whitespace diringkas.
+func (o *phpOutline) text(a, b int) string {
+	if a > b || a < 0 || b >= len(o.toks) {
+		return ""
+	}
+	return strings.Join(strings.Fields(string(o.src[o.toks[a].pos:o.toks[b].end])), " ")
+}
+
+// useImports membaca `use A\B, C as D;` / `use A\{B, C};` mulai dari toks[i].
+func (o *phpOutline) useImports(i int) int {
+	toks := o.toks
+	if i < len(toks) && (toks[i].val == "function" || toks[i].val == "const") {
+		i++
+	}
+	prefix := ""
+	cur := ""
+	flush := func() {
+		if cur != "" {
+			o.file.Imports = append(o.file.Imports, strings.TrimPrefix(prefix+cur, `\`))
+		}
+		cur = ""
+	}
+	for ; i < len(toks); i++ {
+		t := toks[i]
+		switch {
+		case t.val == ";":
+			flush()
+			return i
+		case t.val == ",":
+			flush()
+		case t.val == "{":
+			prefix = strings.TrimSuffix(cur, `\`) + `\`
+			cur = ""
+		case t.val == "}":
+			flush()
+		case t.kind == 'n' && strings.EqualFold(t.val, "as") && i+1 < len(toks):
+			cur += " as " + toks[i+1].val
+			i++
+		case t.kind == 'n':
+			cur += t.val
+		case t.val == `\`:
+			cur += `\`
+		}
+	}
+	return i
+}
+
+// classBody membaca header (extends/implements) dan member class mulai dari toks[i].
+func (o *phpOutline) classBody(i int) int {
+	toks := o.toks
+	pc := &o.file
+	mode := ""
+	for ; i < len(toks) && toks[i].val != "{"; i++ {
+		t := toks[i]
+		switch {
+		case t.val == ":" && pc.Kind == "enum":
+			mode = "backed"
+		case t.kind == 'n' && strings.EqualFold(t.val, "extends"):
+			mode = "extends"
+		case t.kind == 'n' && strings.EqualFold(t.val, "implements"):
+			mode = "implements"
+		case t.kind == 'n':
+			switch mode {
+			case "backed":
+				pc.BackedType = t.val
+			case "extends":
+				pc.Extends = append(pc.Extends, t.val)
+			case "implements":
+				pc.Implements = append(pc.Implements, t.val)
+			}
+		}
+	}
+	if i >= len(toks) {
+		return i
+	}
+	end := o.skipBalanced(i, "{", "}")
+	var mods []string
+	for k := i + 1; k < end; k++ {
+		t := toks[k]
+		lv := strings.ToLower(t.val)
+		switch {
+		case t.val == "#[":
+			k = o.skipBalanced(k, "#[", "]")
+		case t.kind == 'n' && lv == "use" && len(mods) == 0:
+			for k++; k < end && toks[k].val != ";" && toks[k].val != "{"; k++ {
+				if toks[k].kind == 'n' {
+					pc.Traits = append(pc.Traits, toks[k].val)
+				}
+			}
+			if k < end && toks[k].val == "{" {
+				k = o.skipBalanced(k, "{", "}")
+			}
+		case t.kind == 'n' && lv == "case" && pc.Kind == "enum":
+			if k+1 < end {
+				pc.Cases = append(pc.Cases, toks[k+1].val)
+			}
+			k = o.skipToSemicolon(k, end)
+		case t.kind == 'n' && lv == "const":
+			// const [type] NAME = value, NAME2 = value;
+			stop := o.skipToSemicolon(k, end)
+			for _, part := range o.splitComma(k+1, stop-1) {
+				for p := part[0]; p <= part[1]; p++ {
+					if toks[p].val == "=" && p > part[0] {
+						pc.Constants = append(pc.Constants, toks[p-1].val)
+						break
+					}
+				}
+			}
+			mods = nil
+			k = stop
+		case t.kind == 'n' && phpMemberModifiers[lv]:
+			mods = append(mods, lv)
+		case t.kind == 'n' && lv == "function":
+			k = o.method(k, end, mods)
+			mods = nil
+		case t.kind == 'p' && t.val == "{":
+			k = o.skipBalanced(k, "{", "}")
+			mods = nil
+		case len(mods) > 0:
+			// property: [type] $a [= x], $b;
+			k = o.property(k, end, mods)
+			mods = nil
+		}
+	}
+	return end
+}
+
+func (o *phpOutline) skipToSemicolon(k, end int) int {
+	depth := 0
+	for ; k < end; k++ {
+		switch o.toks[k].val {
+		case "(", "[", "{", "#[":
+			depth++
+		case ")", "]", "}":
+			depth--
+		case ";":
+			if depth <= 0 {
+				return k
+			}
+		}
+	}
+	return end
+}
+
+// splitComma membagi range token a..b (inklusif) pada koma level teratas.
+func (o *phpOutline) splitComma(a, b int) [][2]int {
+	var out [][2]int
+	depth := 0
+	start := a
+	for k := a; k <= b && k < len(o.toks); k++ {
+		if o.toks[k].kind != 'p' {
+			continue
+		}
+		switch o.toks[k].val {
+		case "(", "[", "{", "#[":
+			depth++
+		case ")", "]", "}":
+			depth--
+		case ",":
+			if depth == 0 {
+				if k > start {
+					out = append(out, [2]int{start, k - 1})
+				}
+				start = k + 1
+			}
+		}
+	}
+	if start <= b {
+		out = append(out, [2]int{start, b})
+	}
+	return out
+}
+
+func phpVisibility(mods []string) string {
+	for _, m := range mods {
+		switch m {
+		case "public", "protected", "private":
+			return m
+		}
+	}
+	return "public"
+}
+
+func (o *phpOutline) property(k, end int, mods []string) int {
+	toks := o.toks
+	stop := o.skipToSemicolon(k, end)
+	typ := ""
+	for _, part := range o.splitComma(k, stop-1) {
+		p := part[0]
+		for p <= part[1] && toks[p].kind != 'v' {
+			typ += toks[p].val
+			p++
+		}
+		if p > part[1] {
+			continue
+		}
+		prop := PHPProperty{
+			Name:       toks[p].val,
+			Visibility: phpVisibility(mods),
+			Static:     slices.Contains(mods, "static"),
+			Readonly:   slices.Contains(mods, "readonly"),
+			Type:       typ,
+			Line:       toks[p].line,
+		}
+		if p+1 <= part[1] && toks[p+1].val == "=" {
+			prop.Default = truncate(o.text(p+2, part[1]), 240)
+			o.props[prop.Name] = [2]int{p + 2, part[1]}
+		}
+		o.file.Properties = append(o.file.Properties, prop)
+	}
+	return stop
+}
+
+func (o *phpOutline) method(k, end int, mods []string) int {
+	toks := o.toks
+	m := PHPMethod{
+		Visibility: phpVisibility(mods),
+		Static:     slices.Contains(mods, "static"),
+		Abstract:   slices.Contains(mods, "abstract"),
+		Final:      slices.Contains(mods, "final"),
+		Line:       toks[k].line,
+	}
+	k++
+	if k < end && toks[k].val == "&" {
+		k++
+	}
+	if k >= end {
+		return k
+	}
+	m.Name = toks[k].val
+	k++
+	if k < end && toks[k].val == "(" {
+		close := o.skipBalanced(k, "(", ")")
+		for _, part := range o.splitComma(k+1, close-1) {
+			m.Params = append(m.Params, o.param(part[0], part[1]))
+		}
+		k = close + 1
+	}
+	if k < end && toks[k].val == ":" {
+		a := k + 1
+		for k+1 < end && toks[k+1].val != "{" && toks[k+1].val != ";" {
+			k++
+		}
+		m.Returns = strings.ReplaceAll(o.text(a, k), " ", "")
+		k++
+	}
+	if k < end && toks[k].val == "{" {
+		close := o.skipBalanced(k, "{", "}")
+		if _, ok := o.bodies[m.Name]; !ok {
+			o.bodies[m.Name] = [2]int{k + 1, close - 1}
+		}
+		k = close
+	}
+	o.file.Methods = append(o.file.Methods, m)
+	return k
+}
+
+func (o *phpOutline) param(a, b int) PHPParam {
+	toks := o.toks
+	var p PHPParam
+	typ := ""
+	for k := a; k <= b; k++ {
+		t := toks[k]
+		lv := strings.ToLower(t.val)
+		switch {
+		case t.val == "#[":
+			k = o.skipBalanced(k, "#[", "]")
+		case t.kind == 'n' && (lv == "public" || lv == "protected" || lv == "private"):
+			p.Promoted = lv
+		case t.kind == 'n' && lv == "readonly":
+			if p.Promoted == "" {
+				p.Promoted = "public"
+			}
+		case t.val == "&":
+			p.ByRef = true
+		case t.val == "...":
+			p.Variadic = true
+		case t.kind == 'v':
+			p.Name = t.val
+			p.Type = typ
+			if k+1 <= b && toks[k+1].val == "=" {
+				p.Default = truncate(o.text(k+2, b), 120)
+			}
+			return p
+		default:
+			typ += t.val
+		}
+	}
+	p.Type = typ
+	return p
+}
+
+// resolvePHPName mengubah nama pendek menjadi FQCN memakai imports & namespace file.
+func (pc PHPClassFile) resolvePHPName(name string) string {
+	if name == "" {
+		return ""
+	}
+	if strings.HasPrefix(name, `\`) {
+		return strings.TrimPrefix(name, `\`)
+	}
+	head, rest, _ := strings.Cut(name, `\`)
+	for _, imp := range pc.Imports {
+		fq, alias, ok := strings.Cut(imp, " as ")
+		if !ok {
+			alias = fq
+			if i := strings.LastIndex(fq, `\`); i >= 0 {
+				alias = fq[i+1:]
+			}
+		}
+		if strings.EqualFold(alias, head) {
+			if rest != "" {
+				return fq + `\` + rest
+			}
+			return fq
+		}
+	}
+	switch strings.ToLower(name) {
+	case "self", "static", "parent":
+		return name
+	}
+	if pc.Namespace != "" {
+		return pc.Namespace + `\` + name
+	}
+	return name
+}
+
+// ================= PHP literal evaluator =================
+
+// phpArray adalah array literal PHP dengan urutan entry dipertahankan.
+type phpArray []phpEntry
+
+type phpEntry struct {
+	Key    string
+	HasKey bool
+	Val    any
+}
+
+// phpCall merepresentasikan pemanggilan fungsi/static method, mis. env('X', 'd').
+type phpCall struct {
+	Name string
+	Args []any
+}
+
+type phpClassRef string // Foo::class
+
+type phpRaw string // ekspresi yang tidak dievaluasi
+
+// value mengevaluasi ekspresi literal pada token a..b (inklusif).
+func (o *phpOutline) value(a, b int) any {
+	toks := o.toks
+	if a > b || a < 0 || b >= len(toks) {
+		return nil
+	}
+	for toks[a].val == "(" && o.skipBalanced(a, "(", ")") == b && a+1 <= b-1 {
+		a, b = a+1, b-1
+	}
+	t := toks[a]
+	if a == b {
+		switch t.kind {
+		case 's':
+			return t.val
+		case 'n':
+			switch strings.ToLower(t.val) {
+			case "true":
+				return true
+			case "false":
+				return false
+			case "null":
+				return nil
+			}
+		}
+		return phpRaw(t.val)
+	}
+	if t.val == "-" && a+1 == b && toks[b].kind == 'd' {
+		return phpRaw("-" + toks[b].val)
+	}
+	if t.val == "[" && o.skipBalanced(a, "[", "]") == b {
+		return o.array(a+1, b-1)
+	}
+	if t.kind == 'n' && strings.EqualFold(t.val, "array") && toks[a+1].val == "(" && o.skipBalanced(a+1, "(", ")") == b {
+		return o.array(a+2, b-1)
+	}
+	if t.kind == 'n' && a+2 == b && toks[a+1].val == "::" && strings.EqualFold(toks[b].val, "class") {
+		if n := strings.ToLower(t.val); (n == "self" || n == "static") && o.file.Class != "" {
+			return phpClassRef(o.file.resolvePHPName(o.file.Class))
+		}
+		return phpClassRef(o.file.resolvePHPName(t.val))
+	}
+	// fn(args) / Class::fn(args)
+	name := ""
+	k := a
+	if t.kind == 'n' {
+		name = t.val
+		k++
+		if k+1 <= b && toks[k].val == "::" && toks[k+1].kind == 'n' {
+			name += "::" + toks[k+1].val
+			k += 2
+		}
+	}
+	if name != "" && k <= b && toks[k].val == "(" && o.skipBalanced(k, "(", ")") == b {
+		c := phpCall{Name: name}
+		for _, part := range o.splitComma(k+1, b-1) {
+			c.Args = append(c.Args, o.value(part[0], part[1]))
+		}
+		return c
+	}
+	return phpRaw(o.text(a, b))
+}
+
+func (o *phpOutline) array(a, b int) phpArray {
+	out := phpArray{}
+	for _, part := range o.splitComma(a, b) {
+		arrow := -1
+		depth := 0
+		for k := part[0]; k <= part[1]; k++ {
+			switch o.toks[k].val {
+			case "(", "[", "{", "#[":
+				depth++
+			case ")", "]", "}":
+				depth--
+			case "=>":
+				if depth == 0 && arrow < 0 {
+					arrow = k
+				}
+			}
+		}
+		if arrow < 0 {
+			out = append(out, phpEntry{Val: o.value(part[0], part[1])})
+			continue
+		}
+		out = append(out, phpEntry{Key: phpString(o.value(part[0], arrow-1)), HasKey: true, Val: o.value(arrow+1, part[1])})
+	}
+	return out
+}
+
+// phpString mengubah hasil evaluasi menjadi teks ringkas.
+func phpString(v any) string {
+	switch x := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return x
+	case bool:
+		if x {
+			return "true"
+		}
+		return "false"
+	case phpRaw:
+		return string(x)
+	case phpClassRef:
+		return string(x)
+	case phpCall:
+		var args []string
+		for _, a := range x.Args {
+			args = append(args, phpString(a))
+		}
+		return x.Name + "(" + strings.Join(args, ", ") + ")"
+	case phpArray:
+		var parts []string
+		for _, e := range x {
+			if e.HasKey {
+				parts = append(parts, e.Key+" => "+phpString(e.Val))
+			} else {
+				parts = append(parts, phpString(e.Val))
+			}
+		}
+		return "[" + strings.Join(parts, ", ") + "]"
+	}
+	return ""
+}
+
+// phpStrings mengambil nilai list dari array literal (mis. $fillable).
+func phpStrings(v any) []string {
+	arr, ok := v.(phpArray)
+	if !ok {
+		return nil
+	}
+	var out []string
+	for _, e := range arr {
+		out = append(out, phpString(e.Val))
+	}
+	return out
+}
+
+// phpStringMap mengambil pasangan key => value dari array literal (mis. $casts).
+func phpStringMap(v any) map[string]string {
+	arr, ok := v.(phpArray)
+	if !ok {
+		return nil
+	}
+	out := map[string]string{}
+	for _, e := range arr {
+		if e.HasKey {
+			out[e.Key] = phpString(e.Val)
+		}
+	}
+	return out
+}
+
+// propValue mengevaluasi default value sebuah property class.
+func (o *phpOutline) propValue(name string) (any, bool) {
+	r, ok := o.props[name]
+	if !ok {
+		return nil, false
+	}
+	return o.value(r[0], r[1]), true
+}
+
+// returnValue mengevaluasi `return <expr>;` pertama di body method.
+func (o *phpOutline) returnValue(method string) (any, bool) {
+	r, ok := o.bodies[method]
+	if !ok {
+		return nil, false
+	}
+	for k := r[0]; k <= r[1]; k++ {
+		if o.toks[k].kind == 'n' && strings.EqualFold(o.toks[k].val, "return") {
+			end := o.skipToSemicolon(k+1, r[1]+1)
+			return o.value(k+1, end-1), true
+		}
+	}
+	return nil, false
+}
+
+// functionBody mencari body `function name(...) {...}` di mana saja dalam file
+// (termasuk anonymous class seperti migration Laravel).
+func (o *phpOutline) functionBody(name string) ([2]int, bool) {
+	toks := o.toks
+	for k := 0; k+2 < len(toks); k++ {
+		if toks[k].kind != 'n' || !strings.EqualFold(toks[k].val, "function") || !strings.EqualFold(toks[k+1].val, name) || toks[k+2].val != "(" {
+			continue
+		}
+		c := o.skipBalanced(k+2, "(", ")")
+		for c++; c < len(toks) && toks[c].val != "{" && toks[c].val != ";"; c++ {
+		}
+		if c < len(toks) && toks[c].val == "{" {
+			return [2]int{c + 1, o.skipBalanced(c, "{", "}") - 1}, true
+		}
+	}
+	return [2]int{}, false
+}
+
+// phpChainCall adalah satu pemanggilan dalam chain `$x->a(...)->b(...)`.
+type phpChainCall struct {
+	Name string
+	Args []any
+	args [2]int // range token argumen (inklusif)
+}
+
+// chain membaca rangkaian `->name(args)` mulai dari toks[k] (yang berisi "->").
+// Mengembalikan daftar call dan index token setelah chain.
+func (o *phpOutline) chain(k, end int) ([]phpChainCall, int) {
+	toks := o.toks
+	var calls []phpChainCall
+	for k+1 <= end && (toks[k].val == "->" || toks[k].val == "?->" || toks[k].val == "::") && toks[k+1].kind == 'n' {
+		c := phpChainCall{Name: toks[k+1].val}
+		k += 2
+		if k <= end && toks[k].val == "(" {
+			close := o.skipBalanced(k, "(", ")")
+			c.args = [2]int{k + 1, close - 1}
+			for _, part := range o.splitComma(k+1, close-1) {
+				c.Args = append(c.Args, o.value(part[0], part[1]))
+			}
+			k = close + 1
+		}
+		calls = append(calls, c)
+	}
+	return calls, k
+}
+
+// statements membagi range token a..b menjadi statement per `;` level teratas.
+func (o *phpOutline) statements(a, b int) [][2]int {
+	var out [][2]int
+	for k := a; k <= b; {
+		end := o.skipToSemicolon(k, b+1)
+		if end > k {
+			out = append(out, [2]int{k, end - 1})
+		}
+		k = end + 1
+	}
+	return out
+}
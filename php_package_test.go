@@ -0,0 +1,68 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestLexPHP(t *testing.T) {
+	cases := []struct {
+		name string
+		src  string
+		want string // token "kind:val" dipisah spasi
+		line string // baris tiap token; kosong = tidak dicek
+	}{
+		{
+			name: "inline html and open tags",
+			src:  "<html><?php echo $a; ?>text<?= $b ?>",
+			want: "n:echo v:a p:; p:; v:b p:;",
+		},
+		{
+			name: "comments and attributes",
+			src:  "<?php\n// c ?> html <?php\n# c\n/* c */ $x = 1; #[Attr] f();",
+			want: "p:; v:x p:= d:1 p:; p:#[ n:Attr p:] n:f p:( p:) p:;",
+		},
+		{
+			name: "quoted strings",
+			src:  `<?php 'it\'s' "a\"b\n" "x//y" 'q#r';`,
+			want: "s:it's s:a\"b\n s:x//y s:q#r p:;",
+		},
+		{
+			name: "heredoc and nowdoc",
+			src:  "<?php $s = <<<EOT\n  hello\n  EOT;\n$t = <<<'RAW'\nx $y\nRAW;\n",
+			want: "v:s p:= s:  hello p:; v:t p:= s:x $y p:;",
+		},
+		{
+			name: "names, numbers and punctuation",
+			src:  `<?php \App\User::class; $a?->b ?? $c <=> 1.5; $d .= $e**2;`,
+			want: `n:\App\User p::: n:class p:; v:a p:?-> n:b p:?? v:c p:<=> d:1.5 p:; v:d p:.= v:e p:** d:2 p:;`,
+		},
+		{
+			name: "unterminated comment",
+			src:  "<?php $a; /* never closed",
+			want: "v:a p:;",
+		},
+		{
+			name: "line numbers",
+			src:  "<?php\n$a\n/* x\n*/ $b\n<<<E\n1\n2\nE;\n$c",
+			want: "v:a v:b s:1\n2 p:; v:c",
+			line: "2 4 5 8 9",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got, lines []string
+			for _, tok := range lexPHP([]byte(tc.src)) {
+				got = append(got, string(tok.kind)+":"+tok.val)
+				lines = append(lines, fmt.Sprint(tok.line))
+			}
+			if s := strings.Join(got, " "); s != tc.want {
+				t.Errorf("tokens = %q\nwant     %q", s, tc.want)
+			}
+			if s := strings.Join(lines, " "); tc.line != "" && s != tc.line {
+				t.Errorf("lines = %s, want %s", s, tc.line)
+			}
+		})
+	}
+}
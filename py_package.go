@@ -0,0 +1,398 @@
+package main
+
+import (
+	"os"
+	"regexp"
+	"strings"
+)
+
+// ================= Python logical lines =================
+
+// pyLine adalah satu logical line Python: baris fisik yang tersambung lewat kurung
+// atau backslash digabung, komentar dibuang.
+type pyLine struct {
+	Indent int
+	Line   int
+	Text   string
+}
+
+// pyLines memecah source Python menjadi logical lines.
+func pyLines(src string) []pyLine {
+	var out []pyLine
+	var b strings.Builder
+	depth := 0
+	line := 1
+	start := 1
+	indent := -1
+	atLineStart := true
+	col := 0
+	flush := func() {
+		if t := strings.TrimSpace(b.String()); t != "" {
+			out = append(out, pyLine{Indent: max(indent, 0), Line: start, Text: t})
+		}
+		b.Reset()
+		indent = -1
+	}
+	for i := 0; i < len(src); i++ {
+		c := src[i]
+		if atLineStart {
+			if c == ' ' || c == '\t' {
+				col++
+				continue
+			}
+			atLineStart = false
+			if indent < 0 && c != '\n' && c != '\r' && c != '#' {
+				indent = col
+				start = line
+			}
+		}
+		switch {
+		case c == '#':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+			i--
+		case c == '\'' || c == '"':
+			q := string(c)
+			if strings.HasPrefix(src[i:], q+q+q) {
+				q = q + q + q
+			}
+			j := i + len(q)
+			for j < len(src) && !strings.HasPrefix(src[j:], q) {
+				if src[j] == '\\' && j+1 < len(src) {
+					j++
+				}
+				if src[j] == '\n' && len(q) == 1 {
+					break
+				}
+				if src[j] == '\n' {
+					line++
+				}
+				j++
+			}
+			j = min(j+len(q), len(src))
+			b.WriteString(src[i:j])
+			i = j - 1
+		case c == '\\' && i+1 < len(src) && src[i+1] == '\n':
+			b.WriteByte(' ')
+			i++
+			line++
+		case c == '\n':
+			line++
+			if depth > 0 {
+				b.WriteByte(' ')
+			} else {
+				flush()
+			}
+			atLineStart = true
+			col = 0
+		case c == '\r':
+		default:
+			switch c {
+			case '(', '[', '{':
+				depth++
+			case ')', ']', '}':
+				depth = max(depth-1, 0)
+			}
+			b.WriteByte(c)
+		}
+	}
+	flush()
+	return out
+}
+
+// pyBlockEnd mengembalikan index (eksklusif) akhir blok yang dibuka oleh lines[i].
+func pyBlockEnd(lines []pyLine, i int) int {
+	j := i + 1
+	for j < len(lines) && lines[j].Indent > lines[i].Indent {
+		j++
+	}
+	return j
+}
+
+// ================= Python definitions =================
+
+var rePyDef = regexp.MustCompile(`^(?:async\s+)?def\s+(\w+)\s*\((.*)\)\s*(?:->\s*(.+?))?\s*:`)
+
+// pyDef adalah class/def beserta range body-nya di slice logical lines.
+type pyDef struct {
+	Kind       string // class, def
+	Name       string
+	Bases      []string // class: base classes; def: parameter
+	Returns    string
+	Decorators []string
+	Line       int
+	Body       [2]int // index lines [awal, akhir)
+}
+
+// pyDefs mengembalikan class/def yang berada tepat di indent milik lines[a].
+func pyDefs(lines []pyLine, a, b int) []pyDef {
+	if a >= b {
+		return nil
+	}
+	indent := lines[a].Indent
+	var out []pyDef
+	var decos []string
+	for i := a; i < b; i++ {
+		l := lines[i]
+		if l.Indent != indent {
+			continue
+		}
+		if strings.HasPrefix(l.Text, "@") {
+			decos = append(decos, strings.TrimPrefix(l.Text, "@"))
+			continue
+		}
+		d := pyDef{Decorators: decos, Line: l.Line}
+		decos = nil
+		if m := rePyClass.FindStringSubmatch(l.Text); m != nil {
+			d.Kind, d.Name = "class", m[1]
+			d.Bases = splitTopLevel(m[2], ',')
+		} else if m := rePyDef.FindStringSubmatch(l.Text); m != nil {
+			d.Kind, d.Name, d.Returns = "def", m[1], m[3]
+			d.Bases = splitTopLevel(m[2], ',')
+		} else {
+			continue
+		}
+		end := pyBlockEnd(lines, i)
+		d.Body = [2]int{i + 1, end}
+		out = append(out, d)
+		i = end - 1
+	}
+	return out
+}
+
+// pyAssigns mengembalikan assignment `name = expr` di level indent blok lines[a:b].
+func pyAssigns(lines []pyLine, a, b int) []pyItem {
+	if a >= b {
+		return nil
+	}
+	indent := lines[a].Indent
+	var out []pyItem
+	for i := a; i < b; i++ {
+		l := lines[i]
+		if l.Indent != indent {
+			continue
+		}
+		name, expr, ok := pyAssign(l.Text)
+		if ok {
+			out = append(out, pyItem{Key: name, Val: pyValue(expr)})
+		}
+	}
+	return out
+}
+
+var rePyAssignLine = regexp.MustCompile(`^([A-Za-z_][\w.]*)\s*(?::\s*[^=]+?)?\s*(\+?=)\s*(.+)$`)
+
+// pyAssign memecah `x = expr` / `x: T = expr` / `x += expr`; op `+=` ditandai prefix "+".
+func pyAssign(s string) (name, expr string, ok bool) {
+	m := rePyAssignLine.FindStringSubmatch(s)
+	if m == nil || strings.HasPrefix(m[3], "=") {
+		return "", "", false
+	}
+	if m[2] == "+=" {
+		return "+" + m[1], m[3], true
+	}
+	return m[1], m[3], true
+}
+
+// ================= Python literal evaluator =================
+
+type pyList []any
+
+type pyItem struct {
+	Key string
+	Val any
+}
+
+type pyDict []pyItem
+
+// pyCall merepresentasikan pemanggilan, mis. models.CharField(max_length=10).
+type pyCall struct {
+	Name   string
+	Args   []any
+	Kwargs []pyItem
+}
+
+type pyRaw string // ekspresi yang tidak dievaluasi
+
+var rePyCallee = regexp.MustCompile(`^[A-Za-z_][\w.]*$`)
+
+// pyValue mengevaluasi ekspresi literal Python.
+func pyValue(s string) any {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	switch s {
+	case "True":
+		return true
+	case "False":
+		return false
+	case "None":
+		return nil
+	}
+	if str, ok := pyStringLit(s); ok {
+		return str
+	}
+	switch s[0] {
+	case '[', '(', '{':
+		if matchClose(s, 0) == len(s)-1 {
+			inner := s[1 : len(s)-1]
+			parts := splitTopLevel(inner, ',')
+			if s[0] == '{' && len(parts) > 0 && strings.Contains(parts[0], ":") {
+				d := pyDict{}
+				for _, p := range parts {
+					if strings.HasPrefix(p, "**") {
+						continue
+					}
+					kv := splitTopLevel(p, ':')
+					if len(kv) < 2 {
+						continue
+					}
+					d = append(d, pyItem{Key: pyString(pyValue(kv[0])), Val: pyValue(strings.Join(kv[1:], ":"))})
+				}
+				return d
+			}
+			if s[0] == '(' && len(parts) == 1 && !strings.HasSuffix(strings.TrimSpace(inner), ",") {
+				return pyValue(inner)
+			}
+			l := pyList{}
+			for _, p := range parts {
+				l = append(l, pyValue(p))
+			}
+			return l
+		}
+	}
+	if i := strings.IndexByte(s, '('); i > 0 && s[len(s)-1] == ')' && matchClose(s, i) == len(s)-1 && rePyCallee.MatchString(strings.TrimSpace(s[:i])) {
+		c := pyCall{Name: strings.TrimSpace(s[:i])}
+		for _, p := range splitTopLevel(s[i+1:len(s)-1], ',') {
+			if k, v, ok := pyKwarg(p); ok {
+				c.Kwargs = append(c.Kwargs, pyItem{Key: k, Val: pyValue(v)})
+				continue
+			}
+			c.Args = append(c.Args, pyValue(p))
+		}
+		return c
+	}
+	return pyRaw(s)
+}
+
+func pyKwarg(p string) (string, string, bool) {
+	i := strings.IndexByte(p, '=')
+	if i <= 0 || i+1 >= len(p) || p[i+1] == '=' || strings.ContainsAny(p[i-1:i], "!<>=") {
+		return "", "", false
+	}
+	k := strings.TrimSpace(p[:i])
+	if !rePyCallee.MatchString(k) || strings.Contains(k, ".") {
+		return "", "", false
+	}
+	return k, p[i+1:], true
+}
+
+// pyStringLit mengenali satu string literal utuh (dengan prefix r/b/u/f).
+func pyStringLit(s string) (string, bool) {
+	i := 0
+	for i < len(s) && i < 2 && strings.ContainsRune("rRbBuUfF", rune(s[i])) {
+		i++
+	}
+	if i >= len(s) || (s[i] != '\'' && s[i] != '"') {
+		return "", false
+	}
+	q := s[i : i+1]
+	if strings.HasPrefix(s[i:], q+q+q) && len(s)-i >= 6 {
+		q = q + q + q
+	}
+	body := s[i+len(q):]
+	if !strings.HasSuffix(body, q) {
+		return "", false
+	}
+	body = body[:len(body)-len(q)]
+	if len(q) == 1 {
+		for j := 0; j < len(body); j++ {
+			if body[j] == '\\' {
+				j++
+			} else if body[j] == q[0] {
+				return "", false // "a" + "b"
+			}
+		}
+	}
+	return body, true
+}
+
+// pyString mengubah hasil evaluasi menjadi teks ringkas.
+func pyString(v any) string {
+	switch x := v.(type) {
+	case nil:
+		return "None"
+	case string:
+		return x
+	case bool:
+		if x {
+			return "True"
+		}
+		return "False"
+	case pyRaw:
+		return string(x)
+	case pyCall:
+		var args []string
+		for _, a := range x.Args {
+			args = append(args, pyString(a))
+		}
+		for _, kw := range x.Kwargs {
+			args = append(args, kw.Key+"="+pyString(kw.Val))
+		}
+		return x.Name + "(" + strings.Join(args, ", ") + ")"
+	case pyList:
+		var parts []string
+		for _, e := range x {
+			parts = append(parts, pyString(e))
+		}
+		return "[" + strings.Join(parts, ", ") + "]"
+	case pyDict:
+		var parts []string
+		for _, e := range x {
+			parts = append(parts, e.Key+": "+pyString(e.Val))
+		}
+		return "{" + strings.Join(parts, ", ") + "}"
+	}
+	return ""
+}
+
+// pyStrings mengambil isi list/tuple sebagai teks.
+func pyStrings(v any) []string {
+	l, ok := v.(pyList)
+	if !ok {
+		return nil
+	}
+	var out []string
+	for _, e := range l {
+		out = append(out, pyString(e))
+	}
+	return out
+}
+
+func (c pyCall) kwarg(name string) (any, bool) {
+	for _, kw := range c.Kwargs {
+		if kw.Key == name {
+			return kw.Val, true
+		}
+	}
+	return nil, false
+}
+
+func (d pyDict) get(key string) (any, bool) {
+	for _, e := range d {
+		if e.Key == key {
+			return e.Val, true
+		}
+	}
+	return nil, false
+}
+
+func readPyLines(full string) []pyLine {
+	b, err := os.ReadFile(full)
+	if err != nil {
+		return nil
+	}
+	return pyLines(string(b))
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// runQuery menangani subcommand `ctxgen query symbol <name>`.
+// Sumber index: -manifest (JSON atau NDJSON .gz) bila ada, selain itu outline -root langsung.
+func runQuery(args []string) int {
+	fs := flag.NewFlagSet("query", flag.ExitOnError)
+	root := fs.String("root", ".", "project root to outline when -manifest is not set")
+	manifest := fs.String("manifest", "", "manifest JSON or NDJSON .gz produced by ctxgen")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: ctxgen query [-root dir | -manifest file] symbol <name>")
+		fs.PrintDefaults()
+	}
+	_ = fs.Parse(args)
+	if fs.NArg() != 2 || fs.Arg(0) != "symbol" {
+		fs.Usage()
+		return 2
+	}
+
+	var syms []Symbol
+	if *manifest != "" {
+		var err error
+		if syms, err = loadSymbols(*manifest); err != nil {
+			fmt.Fprintf(os.Stderr, "query error: %v\n", err)
+			return 1
+		}
+	} else {
+		abs, _ := filepath.Abs(*root)
+		m := &Manifest{JSOutline: readJSOutline(abs), PythonOutline: readPythonOutline(abs), DotNet: readCSharp(abs, nil)}
+		if pkgs := readGoPackages(abs); len(pkgs) > 0 {
+			m.Go = &GoInfo{Packages: pkgs}
+		}
+		m.JVMOutline, _ = readJVM(abs)
+		syms = buildSymbols(m)
+	}
+
+	found := findSymbols(syms, fs.Arg(1))
+	if len(found) == 0 {
+		fmt.Fprintf(os.Stderr, "symbol %q not found\n", fs.Arg(1))
+		return 1
+	}
+	outJSON("", found)
+	fmt.Println()
+	return 0
+}
+
+// loadSymbols membaca field symbols dari manifest JSON, atau record "symbol" dari NDJSON .gz.
+func loadSymbols(p string) ([]Symbol, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	if !strings.HasSuffix(p, ".gz") {
+		var m Manifest
+		if err := json.NewDecoder(f).Decode(&m); err != nil {
+			return nil, err
+		}
+		return m.Symbols, nil
+	}
+	gr, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	var out []Symbol
+	br := bufio.NewReader(gr)
+	for {
+		line, err := br.ReadBytes('\n')
+		// record file bisa besar; cukup cek prefix type sebelum decode
+		if len(line) > 0 && strings.HasPrefix(string(line[:min(len(line), 32)]), `{"type":"symbol"`) {
+			var rec NDJSONSymbol
+			if json.Unmarshal(line, &rec) == nil {
+				out = append(out, rec.Symbol)
+			}
+		}
+		if err == io.EOF {
+			return out, nil
+		}
+		if err != nil {
+			return out, err
+		}
+	}
+}
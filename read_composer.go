@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"os"
 	"path/filepath"
-	"regexp"
 	"strings"
 )
 
@@ -32,8 +31,8 @@ func phpHas(l *LaravelCtx, sub string) bool {
 		return false
 	}
 	sub = strings.ToLower(sub)
-	for _, set := range [][]PHPClassFile{l.Controllers, l.Middleware, l.Models, l.Traits, l.Helpers} {
-		for _, x := range set {
+	for _, set := range l.buckets() {
+		for _, x := range *set {
 			if strings.Contains(strings.ToLower(x.Class), sub) || strings.Contains(strings.ToLower(x.Path), sub) {
 				return true
 			}
@@ -54,17 +53,6 @@ func hasComposer(c *ComposerInfo, name string) bool {
 	return false
 }
 
-func parsePHP(full string, reNS, reClass, reMeth *regexp.Regexp) PHPClassFile {
-	b, _ := os.ReadFile(full)
-	ns := firstGroup(reNS.FindSubmatch(b), 1)
-	cls := firstGroup(reClass.FindSubmatch(b), 1)
-	var methods []string
-	for _, m := range reMeth.FindAllSubmatch(b, -1) {
-		methods = append(methods, string(m[2]))
-	}
-	return PHPClassFile{Namespace: ns, Class: cls, Methods: methods}
-}
-
 type rawJSON = map[string]any
 
 func looksLaravel(comp *ComposerInfo, root string) bool {
@@ -0,0 +1,336 @@
+package main
+
+import (
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// ================= C# outline =================
+
+var (
+	reCSFileNamespace = regexp.MustCompile(`(?m)^\s*namespace\s+([\w.]+)\s*;`)
+	reCSTypeDecl      = regexp.MustCompile(`^((?:(?:public|private|protected|internal|abstract|sealed|static|partial|readonly|ref|unsafe|new|file)\s+)*)(class|interface|struct|enum|record\s+struct|record\s+class|record)\s+(\w+)`)
+	reCSMethod        = regexp.MustCompile(`^((?:(?:public|private|protected|internal|static|virtual|override|abstract|sealed|async|extern|new|partial|unsafe|readonly)\s+)*)([\w.<>\[\]?,\s]+?)\s+(\w+)\s*(?:<[^>(]*>)?\s*\(`)
+	reCSProperty      = regexp.MustCompile(`^((?:(?:public|private|protected|internal|static|virtual|override|abstract|sealed|new|readonly|const|required|volatile|event)\s+)*)([\w.<>\[\]?,\s]+?)\s+(\w+)\s*(=>.*|=.*)?$`)
+	reCSAccessor      = regexp.MustCompile(`\b(get|set|init)\b`)
+	reCSDbSet         = regexp.MustCompile(`^(?:virtual\s+)?DbSet<([\w.]+)>\??\s+(\w+)`)
+	reCSDIGeneric     = regexp.MustCompile(`\.(?:Try)?(Add(?:Keyed)?(?:Scoped|Transient|Singleton)|AddHostedService|AddDbContext(?:Pool|Factory)?|AddHttpClient|Configure)\s*<([^()]*?)>\s*\(`)
+	reCSDITypeof      = regexp.MustCompile(`\.(?:Try)?Add(Scoped|Transient|Singleton)\s*\(\s*typeof\(([^)]*)\)\s*(?:,\s*typeof\(([^)]*)\))?`)
+	reCSMapGroup      = regexp.MustCompile(`(?:var|RouteGroupBuilder)\s+(\w+)\s*=\s*(\w+)\.MapGroup\(\s*"([^"]*)"\s*\)`)
+	reCSMapVerb       = regexp.MustCompile(`\b(\w+)\.Map(Get|Post|Put|Patch|Delete)\s*\(\s*"([^"]*)"\s*,\s*([\w.]+)?`)
+	reCSCtor          = regexp.MustCompile(`^((?:(?:public|private|protected|internal|static)\s+)*)(\w+)\s*\(`)
+	reCSInterfaceName = regexp.MustCompile(`^I[A-Z]`)
+	reCSEFMigration   = regexp.MustCompile(`(?:^|/)Migrations/(?:\d{14}_\w+|\w+ModelSnapshot)(?:\.Designer)?\.cs$`)
+)
+
+// readCSharp melengkapi DotNetInfo dengan outline .cs, DbContext, registrasi DI dan endpoint.
+func readCSharp(root string, info *DotNetInfo) *DotNetInfo {
+	out := &DotNetInfo{}
+	walkFiles(root, func(full, rel string) {
+		if !strings.HasSuffix(rel, ".cs") || strings.HasSuffix(rel, ".Designer.cs") || strings.HasSuffix(rel, ".g.cs") || reCSEFMigration.MatchString(rel) {
+			return
+		}
+		for _, seg := range strings.Split(rel, "/") {
+			if seg == "bin" || seg == "obj" {
+				return
+			}
+		}
+		b, err := os.ReadFile(full)
+		if err != nil || len(b) > 512<<10 {
+			return
+		}
+		parseCSharpFile(jsStripComments(string(b)), rel, out)
+	})
+	if len(out.Types)+len(out.Services)+len(out.Endpoints) == 0 {
+		return info
+	}
+	for _, t := range out.Types {
+		if !strings.HasSuffix(strings.SplitN(t.Extends, "<", 2)[0], "DbContext") {
+			continue
+		}
+		ctx := DbContextInfo{Name: t.Name, Path: t.Path}
+		for _, m := range t.Members {
+			if s := reCSDbSet.FindStringSubmatch(m.Signature); s != nil {
+				ctx.Sets = append(ctx.Sets, DbSetMapping{Property: s[2], Entity: s[1]})
+			}
+		}
+		out.DbContexts = append(out.DbContexts, ctx)
+	}
+	for _, t := range out.Types {
+		out.Endpoints = append(out.Endpoints, csControllerEndpoints(t)...)
+	}
+	slices.SortStableFunc(out.Services, func(a, b DIRegistration) int {
+		if c := strings.Compare(a.File, b.File); c != 0 {
+			return c
+		}
+		return a.Line - b.Line
+	})
+	if info == nil {
+		info = &DotNetInfo{}
+	}
+	info.Types, info.DbContexts, info.Services, info.Endpoints = out.Types, out.DbContexts, out.Services, out.Endpoints
+	return info
+}
+
+func parseCSharpFile(src, rel string, out *DotNetInfo) {
+	ns := ""
+	if m := reCSFileNamespace.FindStringSubmatch(src); m != nil {
+		ns = m[1]
+	}
+	csTypes(src, src, 0, ns, rel, out)
+
+	for _, m := range reCSDIGeneric.FindAllStringSubmatchIndex(src, -1) {
+		method := src[m[2]:m[3]]
+		args := jvmSplitTypes(src[m[4]:m[5]])
+		if len(args) == 0 {
+			continue
+		}
+		r := DIRegistration{Service: args[0], File: rel, Line: lineAt(src, m[0])}
+		if len(args) > 1 {
+			r.Implementation = args[1]
+		}
+		switch {
+		case strings.HasPrefix(method, "AddDbContext"):
+			r.Lifetime = "dbcontext"
+		case method == "AddHostedService":
+			r.Lifetime = "hosted"
+		case method == "AddHttpClient":
+			r.Lifetime = "httpclient"
+		case method == "Configure":
+			r.Lifetime = "options"
+		default:
+			r.Lifetime = strings.ToLower(strings.TrimPrefix(strings.TrimPrefix(method, "Add"), "Keyed"))
+		}
+		out.Services = append(out.Services, r)
+	}
+	for _, m := range reCSDITypeof.FindAllStringSubmatchIndex(src, -1) {
+		r := DIRegistration{Lifetime: strings.ToLower(src[m[2]:m[3]]), Service: src[m[4]:m[5]], File: rel, Line: lineAt(src, m[0])}
+		if m[6] >= 0 {
+			r.Implementation = src[m[6]:m[7]]
+		}
+		out.Services = append(out.Services, r)
+	}
+
+	// minimal API: app.MapGet("/x", Handler) dan MapGroup("/prefix")
+	groups := map[string]string{}
+	for _, g := range reCSMapGroup.FindAllStringSubmatch(src, -1) {
+		groups[g[1]] = joinPath(groups[g[2]], g[3])
+	}
+	for _, m := range reCSMapVerb.FindAllStringSubmatch(src, -1) {
+		ep := RouteEndpoint{Method: strings.ToUpper(m[2]), Path: joinPath(groups[m[1]], m[3])}
+		if m[4] != "" && m[4] != "async" && m[4] != "delegate" {
+			ep.Action = m[4]
+		}
+		out.Endpoints = append(out.Endpoints, ep)
+	}
+}
+
+// csTypes mengumpulkan type di level namespace (rekursif ke blok namespace).
+func csTypes(src, body string, base int, ns, rel string, out *DotNetInfo) {
+	for _, mem := range jvmMembers(body, base, "csharp") {
+		if strings.HasPrefix(mem.Text, "namespace ") {
+			inner := strings.TrimSpace(strings.TrimPrefix(mem.Text, "namespace "))
+			if ns != "" {
+				inner = ns + "." + inner
+			}
+			csTypes(src, mem.Body, mem.BodyOff, inner, rel, out)
+			continue
+		}
+		m := reCSTypeDecl.FindStringSubmatch(mem.Text)
+		if m == nil {
+			continue
+		}
+		t := CSharpType{Path: rel, Namespace: ns, Kind: strings.Join(strings.Fields(m[2]), " "), Name: m[3], Line: lineAt(src, mem.Off), Modifiers: strings.Fields(m[1]), attrs: mem.Annos}
+		for _, a := range mem.Annos {
+			t.Attributes = append(t.Attributes, csAttrText(a))
+		}
+		rest := strings.TrimSpace(mem.Text[len(m[0]):])
+		if strings.HasPrefix(rest, "<") {
+			if end := jvmAngleClose(rest); end > 0 {
+				rest = strings.TrimSpace(rest[end+1:])
+			}
+		}
+		if strings.HasPrefix(rest, "(") {
+			// primary constructor (record / C# 12)
+			if end := matchClose(rest, 0); end > 0 {
+				for _, p := range splitTopLevel(rest[1:end], ',') {
+					if f := strings.Fields(p); len(f) >= 2 {
+						t.Members = append(t.Members, CSharpMember{Name: f[len(f)-1], Kind: "property", Signature: strings.Join(f, " "), Line: t.Line})
+					}
+				}
+				rest = strings.TrimSpace(rest[end+1:])
+			}
+		}
+		if strings.HasPrefix(rest, ":") {
+			rest = rest[1:]
+			if i := strings.Index(rest, " where "); i >= 0 {
+				rest = rest[:i]
+			}
+			for i, b := range jvmSplitTypes(rest) {
+				if p := strings.IndexByte(b, '('); p > 0 {
+					b = b[:p]
+				}
+				if i == 0 && t.Kind != "interface" && !reCSInterfaceName.MatchString(b) {
+					t.Extends = b
+				} else {
+					t.Implements = append(t.Implements, b)
+				}
+			}
+		}
+		csTypeBody(&t, src, mem)
+		out.Types = append(out.Types, t)
+	}
+}
+
+func csTypeBody(t *CSharpType, src string, mem jvmMember) {
+	publicByDefault := t.Kind == "interface"
+	for i, mm := range jvmMembers(mem.Body, mem.BodyOff, "csharp") {
+		text := mm.Text
+		if i == 0 && t.Kind == "enum" {
+			for _, k := range splitTopLevel(text, ',') {
+				if k = strings.TrimSpace(k); k != "" {
+					k, _, _ = strings.Cut(k, "=")
+					t.Members = append(t.Members, CSharpMember{Name: strings.TrimSpace(k), Kind: "constant", Signature: strings.TrimSpace(k), Line: lineAt(src, mm.Off)})
+				}
+			}
+			break
+		}
+		if reCSTypeDecl.MatchString(text) {
+			continue
+		}
+		cm := CSharpMember{Line: lineAt(src, mm.Off), attrs: mm.Annos}
+		for _, a := range mm.Annos {
+			cm.Attributes = append(cm.Attributes, csAttrText(a))
+		}
+		var mods []string
+		paren := strings.IndexByte(text, '(')
+		switch {
+		case reCSCtor.MatchString(text) && reCSCtor.FindStringSubmatch(text)[2] == t.Name:
+			m := reCSCtor.FindStringSubmatch(text)
+			mods = strings.Fields(m[1])
+			cm.Kind, cm.Name = "ctor", t.Name
+			cm.Signature = csSignature(text[len(m[1]):])
+		case paren >= 0 && !strings.Contains(text[:paren], "=") && reCSMethod.MatchString(text):
+			m := reCSMethod.FindStringSubmatch(text)
+			mods = strings.Fields(m[1])
+			cm.Kind, cm.Name = "method", m[3]
+			cm.Signature = csSignature(text[len(m[1]):])
+		case reCSProperty.MatchString(text):
+			m := reCSProperty.FindStringSubmatch(text)
+			mods = strings.Fields(m[1])
+			cm.Name = m[3]
+			cm.Kind = "field"
+			cm.Signature = strings.TrimSpace(m[2]) + " " + m[3]
+			switch {
+			case slices.Contains(mods, "event"):
+				cm.Kind = "event"
+			case mm.Body != "":
+				cm.Kind = "property"
+				cm.Signature += " { " + strings.Join(unique(reCSAccessor.FindAllString(mm.Body, -1)), "; ") + "; }"
+			case strings.HasPrefix(m[4], "=>"):
+				cm.Kind = "property"
+				cm.Signature += " { get; }"
+			}
+		default:
+			continue
+		}
+		if !slices.Contains(mods, "public") && !(publicByDefault && !slices.Contains(mods, "private")) {
+			continue
+		}
+		t.Members = append(t.Members, cm)
+	}
+}
+
+// csSignature membuang body ekspresi `=> ...` dan constraint `where`.
+func csSignature(s string) string {
+	if p := strings.IndexByte(s, '('); p >= 0 {
+		if end := matchClose(s, p); end > 0 {
+			tail := s[end+1:]
+			for _, stop := range []string{"=>", " where ", ":"} {
+				if i := strings.Index(tail, stop); i >= 0 {
+					tail = tail[:i]
+				}
+			}
+			s = s[:end+1] + strings.TrimRight(tail, " ")
+		}
+	}
+	return truncate(strings.TrimSpace(s), 200)
+}
+
+func csAttrText(a tsDecorator) string {
+	if len(a.Args) == 0 {
+		return a.Name
+	}
+	return a.Name + "(" + truncate(strings.Join(strings.Fields(strings.Join(a.Args, ", ")), " "), 120) + ")"
+}
+
+var csHTTPVerbs = map[string]string{"HttpGet": "GET", "HttpPost": "POST", "HttpPut": "PUT", "HttpPatch": "PATCH", "HttpDelete": "DELETE", "HttpHead": "HEAD", "HttpOptions": "OPTIONS"}
+
+// csControllerEndpoints membaca [Route]/[HttpGet] pada controller ASP.NET Core.
+func csControllerEndpoints(t CSharpType) []RouteEndpoint {
+	isController := slices.ContainsFunc(t.attrs, func(a tsDecorator) bool { return a.Name == "ApiController" }) ||
+		t.Extends == "ControllerBase" || t.Extends == "Controller" || strings.HasSuffix(t.Extends, "Controller")
+	if !isController || t.Kind != "class" {
+		return nil
+	}
+	short := strings.TrimSuffix(t.Name, "Controller")
+	fqcn := t.Name
+	if t.Namespace != "" {
+		fqcn = t.Namespace + "." + t.Name
+	}
+	base := ""
+	for _, a := range t.attrs {
+		if a.Name == "Route" {
+			base = jvmAnnoArg(a, "", "Template")
+		}
+	}
+	var out []RouteEndpoint
+	for _, m := range t.Members {
+		if m.Kind != "method" {
+			continue
+		}
+		route, hasRoute := "", false
+		var verbs []string
+		for _, a := range m.attrs {
+			if v, ok := csHTTPVerbs[a.Name]; ok {
+				verbs = append(verbs, v)
+				if r := jvmAnnoArg(a, "", "Template"); r != "" {
+					route, hasRoute = r, true
+				}
+			}
+			if a.Name == "Route" {
+				route, hasRoute = jvmAnnoArg(a, "", "Template"), true
+			}
+		}
+		if len(verbs) == 0 {
+			if !hasRoute {
+				continue
+			}
+			verbs = []string{"ANY"}
+		}
+		p := route
+		if !strings.HasPrefix(route, "/") && !strings.HasPrefix(route, "~/") {
+			p = joinPath(base, route)
+		}
+		p = strings.TrimPrefix(p, "~")
+		p = strings.NewReplacer("[controller]", strings.ToLower(short), "[action]", m.Name).Replace(p)
+		if !strings.HasPrefix(p, "/") {
+			p = "/" + p
+		}
+		ep := RouteEndpoint{Path: p, Controller: fqcn, Action: m.Name}
+		if i := strings.IndexByte(m.Signature, '('); i >= 0 {
+			for _, param := range splitTopLevel(m.Signature[i+1:max(strings.LastIndexByte(m.Signature, ')'), i+1)], ',') {
+				if strings.Contains(param, "[FromBody]") {
+					ep.Request = jvmParamType(strings.ReplaceAll(param, "[FromBody]", ""))
+				}
+			}
+		}
+		for _, v := range verbs {
+			ep.Method = v
+			out = append(out, ep)
+		}
+	}
+	return out
+}
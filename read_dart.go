@@ -1,23 +1,98 @@
 package main
 
-import "path/filepath"
+import (
+	"maps"
+	"path/filepath"
+	"slices"
+)
 
 func readDart(root string) *DartInfo {
 	f := filepath.Join(root, "pubspec.yaml")
 	if !exists(f) {
 		return nil
 	}
-	j := parseYAMLLight(f, 128*1024)
+	j := readYAML(f)
 	di := &DartInfo{
-		Name:         toStr(nested(j, "name")),
-		Dependencies: toStrMap(nested(j, "dependencies")),
-		DevDeps:      toStrMap(nested(j, "dev_dependencies")),
+		Name:        toStr(j["name"]),
+		Version:     yamlStr(j["version"]),
+		Environment: map[string]string{},
+		Assets:      toStrings(nested(j, "flutter", "assets")),
 	}
-	if nested(j, "flutter") != nil {
+	if env, ok := j["environment"].(map[string]any); ok {
+		for k, v := range env {
+			di.Environment[k] = yamlStr(v)
+		}
+	}
+	for _, sec := range []struct {
+		key, kind string
+		dst       *map[string]string
+	}{
+		{"dependencies", "main", &di.Dependencies},
+		{"dev_dependencies", "dev", &di.DevDeps},
+		{"dependency_overrides", "override", &di.Overrides},
+	} {
+		deps, _ := j[sec.key].(map[string]any)
+		for _, name := range slices.Sorted(maps.Keys(deps)) {
+			d := dartDep(name, sec.kind, deps[name])
+			if *sec.dst == nil {
+				*sec.dst = map[string]string{}
+			}
+			(*sec.dst)[name] = dartDepSpec(d)
+			di.Packages = append(di.Packages, d)
+		}
+	}
+	if _, ok := j["flutter"]; ok || hasAnyKey(di.Dependencies, "flutter") {
 		di.Flutter = true
 	}
-	if di.Name == "" && len(di.Dependencies) == 0 && len(di.DevDeps) == 0 && !di.Flutter {
+	if di.Name == "" && len(di.Packages) == 0 && !di.Flutter {
 		return nil
 	}
 	return di
 }
+
+// dartDep: "^1.0.0", null (any), {git: url | {url, ref, path}}, {path: ..}, {sdk: flutter}, {hosted: .., version: ..}.
+func dartDep(name, kind string, v any) DartDep {
+	d := DartDep{Name: name, Kind: kind, Source: "hosted"}
+	m, ok := v.(map[string]any)
+	if !ok {
+		d.Version = yamlStr(v)
+		return d
+	}
+	d.Version = yamlStr(m["version"])
+	switch {
+	case m["git"] != nil:
+		d.Source = "git"
+		if g, ok := m["git"].(map[string]any); ok {
+			d.URL, d.Ref, d.Path = toStr(g["url"]), yamlStr(g["ref"]), toStr(g["path"])
+		} else {
+			d.URL = toStr(m["git"])
+		}
+	case m["path"] != nil:
+		d.Source, d.Path = "path", toStr(m["path"])
+	case m["sdk"] != nil:
+		d.Source, d.SDK = "sdk", toStr(m["sdk"])
+	case m["hosted"] != nil:
+		// bentuk lama {hosted: {name, url}} atau baru {hosted: url}
+		if h, ok := m["hosted"].(map[string]any); ok {
+			d.URL = toStr(h["url"])
+		} else {
+			d.URL = toStr(m["hosted"])
+		}
+	}
+	return d
+}
+
+func dartDepSpec(d DartDep) string {
+	switch d.Source {
+	case "git":
+		return "git:" + d.URL
+	case "path":
+		return "path:" + d.Path
+	case "sdk":
+		return "sdk:" + d.SDK
+	}
+	if d.Version == "" {
+		return "any"
+	}
+	return d.Version
+}
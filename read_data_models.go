@@ -0,0 +1,730 @@
+package main
+
+import (
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// readDataModels mengumpulkan model ORM JS/TS: schema.prisma, entity TypeORM,
+// model Sequelize dan tabel Drizzle.
+func readDataModels(root string) []DataModel {
+	var out []DataModel
+	walkFiles(root, func(full, rel string) {
+		lower := strings.ToLower(rel)
+		switch {
+		case strings.HasSuffix(lower, ".prisma"):
+			b, err := os.ReadFile(full)
+			if err == nil {
+				out = append(out, parsePrisma(string(b), rel)...)
+			}
+			return
+		case strings.HasSuffix(lower, ".d.ts"):
+			return
+		case strings.HasSuffix(lower, ".ts"), strings.HasSuffix(lower, ".js"), strings.HasSuffix(lower, ".mjs"), strings.HasSuffix(lower, ".cjs"):
+		default:
+			return
+		}
+		b, err := os.ReadFile(full)
+		if err != nil || len(b) > 1<<20 {
+			return
+		}
+		text := string(b)
+		if !strings.Contains(text, "@Entity") && !reSeqImport.MatchString(text) && !strings.Contains(text, "drizzle-orm") {
+			return
+		}
+		src := jsStripComments(text)
+		if strings.Contains(src, "@Entity") {
+			out = append(out, parseTypeORM(src, rel)...)
+		}
+		if reSeqImport.MatchString(src) {
+			out = append(out, parseSequelize(src, rel)...)
+		}
+		if strings.Contains(src, "drizzle-orm") {
+			out = append(out, parseDrizzle(src, rel)...)
+		}
+	})
+	slices.SortStableFunc(out, func(a, b DataModel) int {
+		if c := strings.Compare(a.File, b.File); c != 0 {
+			return c
+		}
+		return a.Line - b.Line
+	})
+	return out
+}
+
+// ================= Prisma =================
+
+var (
+	rePrismaBlock = regexp.MustCompile(`^(model|enum|type|view)\s+(\w+)\s*\{`)
+	rePrismaField = regexp.MustCompile(`^(\w+)\s+([\w.]+)(\[\])?(\?)?\s*(.*)$`)
+	rePrismaAttr  = regexp.MustCompile(`@(\w+(?:\.\w+)?)`)
+)
+
+// prismaStripComment membuang komentar // di luar string ("https://..." tetap utuh).
+func prismaStripComment(s string) string {
+	quoted := false
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case quoted && c == '\\':
+			i++
+		case c == '"':
+			quoted = !quoted
+		case !quoted && c == '/' && i+1 < len(s) && s[i+1] == '/':
+			return s[:i]
+		}
+	}
+	return s
+}
+
+func parsePrisma(src, rel string) []DataModel {
+	var out []DataModel
+	var cur *DataModel
+	type pending struct {
+		model int
+		rel   DataRelation
+		list  bool
+	}
+	var rels []pending
+	for i, raw := range strings.Split(src, "\n") {
+		line := strings.TrimSpace(prismaStripComment(raw))
+		if line == "" {
+			continue
+		}
+		if cur == nil {
+			if m := rePrismaBlock.FindStringSubmatch(line); m != nil {
+				kind := "model"
+				if m[1] == "enum" {
+					kind = "enum"
+				}
+				out = append(out, DataModel{Name: m[2], Kind: kind, Source: "prisma", File: rel, Line: i + 1})
+				cur = &out[len(out)-1]
+			}
+			continue
+		}
+		if line == "}" {
+			cur = nil
+			continue
+		}
+		if cur.Kind == "enum" {
+			if f := strings.Fields(line); len(f) > 0 && !strings.HasPrefix(f[0], "@") {
+				cur.Values = append(cur.Values, f[0])
+			}
+			continue
+		}
+		if strings.HasPrefix(line, "@@") {
+			name, args := prismaAttr(line, 2)
+			switch name {
+			case "map":
+				cur.Table = trimQuotes(args)
+			case "id":
+				for _, c := range prismaList(args) {
+					for k := range cur.Fields {
+						if cur.Fields[k].Name == c {
+							cur.Fields[k].Primary = true
+						}
+					}
+				}
+			}
+			continue
+		}
+		m := rePrismaField.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		f := DataField{Name: m[1], Type: m[2], List: m[3] != "", Nullable: m[4] != ""}
+		attrs := m[5]
+		var relation *DataRelation
+		for _, loc := range rePrismaAttr.FindAllStringSubmatchIndex(attrs, -1) {
+			name, args := prismaAttr(attrs[loc[0]:], 1)
+			switch name {
+			case "id":
+				f.Primary = true
+			case "unique":
+				f.Unique = true
+			case "default":
+				f.Default = args
+			case "map":
+				f.Column = trimQuotes(args)
+			case "relation":
+				relation = &DataRelation{}
+				for _, p := range splitTopLevel(args, ',') {
+					k, v, ok := strings.Cut(p, ":")
+					if !ok {
+						continue
+					}
+					switch strings.TrimSpace(k) {
+					case "fields":
+						relation.Fields = prismaList(v)
+					case "references":
+						relation.References = prismaList(v)
+					}
+				}
+			}
+		}
+		if relation != nil {
+			relation.Name, relation.Target = f.Name, f.Type
+			rels = append(rels, pending{model: len(out) - 1, rel: *relation, list: f.List})
+			continue
+		}
+		cur.Fields = append(cur.Fields, f)
+	}
+
+	// field yang bertipe model lain adalah relasi (implisit bila tanpa @relation)
+	models := map[string]int{}
+	for i, m := range out {
+		if m.Kind == "model" {
+			models[m.Name] = i
+		}
+	}
+	for i := range out {
+		var keep []DataField
+		for _, f := range out[i].Fields {
+			if _, ok := models[f.Type]; ok {
+				rels = append(rels, pending{model: i, rel: DataRelation{Name: f.Name, Target: f.Type}, list: f.List})
+				continue
+			}
+			keep = append(keep, f)
+		}
+		out[i].Fields = keep
+	}
+	listBack := func(from, to string) bool {
+		for _, p := range rels {
+			if out[p.model].Name == from && p.rel.Target == to && p.list {
+				return true
+			}
+		}
+		return false
+	}
+	for _, p := range rels {
+		r := p.rel
+		self := &out[p.model]
+		switch {
+		case p.list && listBack(r.Target, self.Name):
+			r.Type = "many-to-many"
+		case p.list:
+			r.Type = "one-to-many"
+		case len(r.Fields) > 0 && !prismaUnique(self, r.Fields):
+			r.Type = "many-to-one"
+		default:
+			r.Type = "one-to-one"
+		}
+		self.Relations = append(self.Relations, r)
+	}
+	return out
+}
+
+// prismaAttr mengambil nama attribute dan isi kurungnya dari "@default(now())".
+func prismaAttr(s string, at int) (string, string) {
+	s = s[at:]
+	i := strings.IndexAny(s, "( ")
+	if i < 0 {
+		return s, ""
+	}
+	name := s[:i]
+	if s[i] != '(' {
+		return name, ""
+	}
+	end := matchClose(s, i)
+	if end < 0 {
+		return name, strings.TrimSpace(s[i+1:])
+	}
+	return name, strings.TrimSpace(s[i+1 : end])
+}
+
+func prismaList(s string) []string {
+	s = strings.Trim(strings.TrimSpace(s), "[]")
+	var out []string
+	for _, p := range splitTopLevel(s, ',') {
+		if i := strings.IndexByte(p, '('); i > 0 {
+			p = p[:i] // id(sort: Desc)
+		}
+		out = append(out, strings.TrimSpace(p))
+	}
+	return out
+}
+
+func prismaUnique(m *DataModel, fields []string) bool {
+	if len(fields) != 1 {
+		return false
+	}
+	for _, f := range m.Fields {
+		if f.Name == fields[0] {
+			return f.Unique || f.Primary
+		}
+	}
+	return false
+}
+
+// ================= TypeORM =================
+
+var (
+	reTSClass    = regexp.MustCompile(`class\s+(\w+)`)
+	reTSProperty = regexp.MustCompile(`^(?:(?:public|private|protected|readonly|declare|override)\s+)*(\w+)([?!])?\s*:\s*([^=]+?)(?:\s*=\s*(.+))?$`)
+	reTSDecoName = regexp.MustCompile(`^@(\w+)`)
+	reArrowType  = regexp.MustCompile(`^\(?\s*\w*\s*\)?\s*=>\s*([\w.]+)`)
+	reTSEntity   = regexp.MustCompile(`@Entity\b`)
+)
+
+type tsDecorator struct {
+	Name string
+	Args []string
+}
+
+type tsMember struct {
+	Decorators []tsDecorator
+	Text       string
+	Offset     int
+}
+
+// tsMembers memecah body class menjadi member beserta decorator-nya.
+func tsMembers(body string, base int) []tsMember {
+	var out []tsMember
+	var decos []tsDecorator
+	i := 0
+	for i < len(body) {
+		for i < len(body) && strings.ContainsRune(" \t\r\n;,", rune(body[i])) {
+			i++
+		}
+		if i >= len(body) {
+			break
+		}
+		if body[i] == '@' {
+			m := reTSDecoName.FindStringSubmatch(body[i:])
+			if m == nil {
+				i++
+				continue
+			}
+			d := tsDecorator{Name: m[1]}
+			i += len(m[0])
+			if i < len(body) && body[i] == '(' {
+				args, end := jsCallArgs(body, i)
+				if end < 0 {
+					break
+				}
+				d.Args = args
+				i = end + 1
+			}
+			decos = append(decos, d)
+			continue
+		}
+		start := i
+		depth := 0
+	scan:
+		for ; i < len(body); i++ {
+			switch body[i] {
+			case '(', '[', '<':
+				depth++
+			case ')', ']', '>':
+				if body[i] == '>' && i > 0 && body[i-1] == '=' {
+					continue // arrow function
+				}
+				depth--
+			case '{':
+				end := matchClose(body, i)
+				if end < 0 {
+					i = len(body)
+					break scan
+				}
+				i = end
+				if depth == 0 && strings.Contains(body[start:i], "(") {
+					i++
+					break scan // method body
+				}
+			case '"', '\'', '`':
+				j := i + 1
+				for j < len(body) && body[j] != body[i] {
+					if body[j] == '\\' {
+						j++
+					}
+					j++
+				}
+				i = j
+			case ';', '\n':
+				if depth <= 0 {
+					break scan
+				}
+			}
+		}
+		out = append(out, tsMember{Decorators: decos, Text: strings.TrimSpace(body[start:min(i, len(body))]), Offset: base + start})
+		decos = nil
+	}
+	return out
+}
+
+func parseTypeORM(src, rel string) []DataModel {
+	var out []DataModel
+	for _, loc := range reTSEntity.FindAllStringIndex(src, -1) {
+		k := loc[1]
+		var entityArgs []string
+		if k < len(src) && src[k] == '(' {
+			args, end := jsCallArgs(src, k)
+			if end < 0 {
+				continue
+			}
+			entityArgs, k = args, end+1
+		}
+		cm := reTSClass.FindStringSubmatchIndex(src[k:])
+		if cm == nil {
+			continue
+		}
+		name := src[k+cm[2] : k+cm[3]]
+		open := strings.IndexByte(src[k+cm[1]:], '{')
+		if open < 0 {
+			continue
+		}
+		open += k + cm[1]
+		end := matchClose(src, open)
+		if end < 0 {
+			continue
+		}
+		dm := DataModel{Name: name, Kind: "model", Source: "typeorm", File: rel, Line: lineAt(src, k+cm[0]), Table: name}
+		if len(entityArgs) > 0 {
+			switch v := jsValue(entityArgs[0]).(type) {
+			case string:
+				dm.Table = v
+			case jsObject:
+				if t := v.str("name"); t != "" {
+					dm.Table = t
+				}
+			}
+		}
+		for _, mem := range tsMembers(src[open+1:end], open+1) {
+			pm := reTSProperty.FindStringSubmatch(mem.Text)
+			if pm == nil || len(mem.Decorators) == 0 {
+				continue
+			}
+			f := DataField{Name: pm[1], Type: strings.TrimSpace(pm[3]), Nullable: pm[2] == "?"}
+			isField := false
+			var relation *DataRelation
+			var joinColumn, joinTable jsObject
+			for _, d := range mem.Decorators {
+				var opts jsObject
+				for _, a := range d.Args {
+					if o, ok := jsValue(a).(jsObject); ok {
+						opts = o
+					}
+				}
+				switch d.Name {
+				case "PrimaryGeneratedColumn", "PrimaryColumn", "ObjectIdColumn":
+					isField, f.Primary = true, true
+					if d.Name == "PrimaryGeneratedColumn" {
+						f.Default = "increment"
+						if len(d.Args) > 0 {
+							if s, ok := jsValue(d.Args[0]).(string); ok {
+								f.Default = s
+							}
+						}
+					}
+				case "Column", "CreateDateColumn", "UpdateDateColumn", "DeleteDateColumn", "VersionColumn":
+					isField = true
+					if len(d.Args) > 0 {
+						if s, ok := jsValue(d.Args[0]).(string); ok {
+							f.Type = s
+						}
+					}
+				case "ManyToOne", "OneToMany", "OneToOne", "ManyToMany":
+					relation = &DataRelation{Name: f.Name, Type: map[string]string{
+						"ManyToOne": "many-to-one", "OneToMany": "one-to-many", "OneToOne": "one-to-one", "ManyToMany": "many-to-many",
+					}[d.Name]}
+					if len(d.Args) > 0 {
+						a := strings.TrimSpace(d.Args[0])
+						if m := reArrowType.FindStringSubmatch(a); m != nil {
+							relation.Target = m[1]
+						} else {
+							relation.Target = trimQuotes(a)
+						}
+					}
+				case "JoinColumn":
+					joinColumn = opts
+				case "JoinTable":
+					joinTable = opts
+				}
+				if opts != nil && d.Name != "JoinColumn" && d.Name != "JoinTable" {
+					if t := opts.str("type"); t != "" {
+						f.Type = t
+					}
+					if opts.str("nullable") == "true" {
+						f.Nullable = true
+					}
+					if opts.str("unique") == "true" {
+						f.Unique = true
+					}
+					if opts.str("primary") == "true" {
+						f.Primary = true
+					}
+					if v, ok := opts.get("default"); ok {
+						f.Default = jsString(v)
+					}
+					if c := opts.str("name"); c != "" && c != f.Name {
+						f.Column = c
+					}
+				}
+			}
+			if relation != nil {
+				if c := joinColumn.str("name"); c != "" {
+					relation.Fields = []string{c}
+				}
+				if rc := joinColumn.str("referencedColumnName"); rc != "" {
+					relation.References = []string{rc}
+				}
+				relation.Through = joinTable.str("name")
+				dm.Relations = append(dm.Relations, *relation)
+			} else if isField {
+				dm.Fields = append(dm.Fields, f)
+			}
+		}
+		out = append(out, dm)
+	}
+	return out
+}
+
+// ================= Sequelize =================
+
+var (
+	reSeqImport = regexp.MustCompile(`\b[sS]equelize`)
+	reSeqDefine = regexp.MustCompile(`(?:const|let|var)?\s*(\w+)\s*=\s*[\w.]*\.define\(\s*['"](\w+)['"]\s*,`)
+	reSeqInit   = regexp.MustCompile(`\b(\w+)\.init\(\s*\{`)
+	reSeqAssoc  = regexp.MustCompile(`\b(\w+)\.(hasMany|hasOne|belongsTo|belongsToMany)\(\s*(?:[\w.]+\.)?(\w+)\s*([,)])`)
+)
+
+func parseSequelize(src, rel string) []DataModel {
+	var out []DataModel
+	byVar := map[string]int{}
+	add := func(varName, name string, at int, attrs, opts string) {
+		dm := DataModel{Name: name, Kind: "model", Source: "sequelize", File: rel, Line: lineAt(src, at)}
+		if o, ok := jsValue(opts).(jsObject); ok {
+			dm.Table = o.str("tableName")
+		}
+		if o, ok := jsValue(attrs).(jsObject); ok {
+			for _, p := range o {
+				dm.Fields = append(dm.Fields, sequelizeField(p))
+			}
+		}
+		byVar[varName] = len(out)
+		out = append(out, dm)
+	}
+	for _, m := range reSeqDefine.FindAllStringSubmatchIndex(src, -1) {
+		args, end := jsCallArgs(src, strings.LastIndexByte(src[:m[4]], '('))
+		if end < 0 || len(args) < 2 {
+			continue
+		}
+		opts := ""
+		if len(args) > 2 {
+			opts = args[2]
+		}
+		add(src[m[2]:m[3]], src[m[4]:m[5]], m[0], args[1], opts)
+	}
+	for _, m := range reSeqInit.FindAllStringSubmatchIndex(src, -1) {
+		open := m[1] - 2
+		for src[open] != '(' {
+			open--
+		}
+		args, end := jsCallArgs(src, open)
+		if end < 0 || len(args) == 0 {
+			continue
+		}
+		opts := ""
+		if len(args) > 1 {
+			opts = args[1]
+		}
+		name := src[m[2]:m[3]]
+		add(name, name, m[0], args[0], opts)
+	}
+	for _, m := range reSeqAssoc.FindAllStringSubmatchIndex(src, -1) {
+		i, ok := byVar[src[m[2]:m[3]]]
+		if !ok {
+			continue
+		}
+		kind := src[m[4]:m[5]]
+		r := DataRelation{Target: src[m[6]:m[7]], Type: map[string]string{
+			"hasMany": "one-to-many", "hasOne": "one-to-one", "belongsTo": "many-to-one", "belongsToMany": "many-to-many",
+		}[kind]}
+		r.Name = lowerFirst(r.Target)
+		if src[m[8]:m[9]] == "," {
+			open := strings.LastIndexByte(src[:m[8]], '(')
+			if args, end := jsCallArgs(src, open); end > 0 && len(args) > 1 {
+				if o, ok := jsValue(args[1]).(jsObject); ok {
+					if as := o.str("as"); as != "" {
+						r.Name = as
+					}
+					if fk := o.str("foreignKey"); fk != "" {
+						if fo, ok := jsValue(fk).(jsObject); ok {
+							fk = fo.str("name")
+						}
+						r.Fields = []string{fk}
+					}
+					if th, ok := o.get("through"); ok {
+						r.Through = jsString(th)
+						if to, ok := th.(jsObject); ok {
+							r.Through = to.str("model")
+						}
+					}
+				}
+			}
+		}
+		out[i].Relations = append(out[i].Relations, r)
+	}
+	return out
+}
+
+func sequelizeField(p jsProp) DataField {
+	f := DataField{Name: p.Key, Nullable: true}
+	dataType := func(s string) string {
+		for _, pre := range []string{"DataTypes.", "Sequelize.", "DataType.", "Types."} {
+			s = strings.TrimPrefix(s, pre)
+		}
+		return s
+	}
+	o, ok := p.Val.(jsObject)
+	if !ok {
+		f.Type = dataType(jsString(p.Val))
+		return f
+	}
+	f.Type = dataType(o.str("type"))
+	if o.str("allowNull") == "false" {
+		f.Nullable = false
+	}
+	if o.str("primaryKey") == "true" {
+		f.Primary, f.Nullable = true, false
+	}
+	if o.str("unique") == "true" {
+		f.Unique = true
+	}
+	if v, ok := o.get("defaultValue"); ok {
+		f.Default = dataType(jsString(v))
+	}
+	if c := o.str("field"); c != "" && c != f.Name {
+		f.Column = c
+	}
+	return f
+}
+
+// ================= Drizzle =================
+
+var (
+	reDrizzleTable = regexp.MustCompile(`(?:export\s+)?const\s+(\w+)\s*=\s*(?:pg|mysql|sqlite)Table\(\s*['"]([^'"]+)['"]\s*,\s*`)
+	reDrizzleEnum  = regexp.MustCompile(`(?:export\s+)?const\s+(\w+)\s*=\s*(?:pg|mysql)Enum\(\s*['"]([^'"]+)['"]\s*,\s*\[`)
+	reDrizzleRels  = regexp.MustCompile(`relations\(\s*(\w+)\s*,`)
+	reDrizzleOne   = regexp.MustCompile(`^(one|many)\(\s*(\w+)\s*(?:,\s*(\{.*\}))?\s*\)$`)
+	reDrizzleRef   = regexp.MustCompile(`\.references\(\s*\(\)\s*=>\s*(\w+)\.(\w+)`)
+	reDrizzleChain = regexp.MustCompile(`\.(\w+)\(`)
+)
+
+func parseDrizzle(src, rel string) []DataModel {
+	var out []DataModel
+	byVar := map[string]int{}
+	for _, m := range reDrizzleTable.FindAllStringSubmatchIndex(src, -1) {
+		if m[1] >= len(src) || src[m[1]] != '{' {
+			continue
+		}
+		end := matchClose(src, m[1])
+		if end < 0 {
+			continue
+		}
+		name := src[m[2]:m[3]]
+		dm := DataModel{Name: name, Kind: "model", Source: "drizzle", File: rel, Line: lineAt(src, m[0]), Table: src[m[4]:m[5]]}
+		obj, _ := jsValue(src[m[1] : end+1]).(jsObject)
+		for _, p := range obj {
+			expr := jsString(p.Val)
+			f := DataField{Name: p.Key, Nullable: true}
+			if i := strings.IndexByte(expr, '('); i > 0 {
+				f.Type = expr[:i]
+				if args, e := jsCallArgs(expr, i); e > 0 && len(args) > 0 {
+					if c, ok := jsValue(args[0]).(string); ok && c != p.Key {
+						f.Column = c
+					}
+				}
+			}
+			for _, c := range reDrizzleChain.FindAllStringSubmatchIndex(expr, -1) {
+				switch expr[c[2]:c[3]] {
+				case "notNull":
+					f.Nullable = false
+				case "primaryKey":
+					f.Primary, f.Nullable = true, false
+				case "unique":
+					f.Unique = true
+				case "array":
+					f.List = true
+				case "default", "defaultNow", "defaultRandom", "$defaultFn":
+					f.Default = strings.TrimPrefix(expr[c[2]:c[3]], "$")
+					if args, e := jsCallArgs(expr, c[1]-1); e > 0 && len(args) > 0 {
+						f.Default = args[0]
+					}
+				}
+			}
+			if r := reDrizzleRef.FindStringSubmatch(expr); r != nil {
+				dm.Relations = append(dm.Relations, DataRelation{Name: p.Key, Type: "many-to-one", Target: r[1], Fields: []string{p.Key}, References: []string{r[2]}})
+			}
+			dm.Fields = append(dm.Fields, f)
+		}
+		byVar[name] = len(out)
+		out = append(out, dm)
+	}
+	for _, m := range reDrizzleEnum.FindAllStringSubmatchIndex(src, -1) {
+		end := matchClose(src, m[1]-1)
+		if end < 0 {
+			continue
+		}
+		dm := DataModel{Name: src[m[2]:m[3]], Kind: "enum", Source: "drizzle", File: rel, Line: lineAt(src, m[0]), Table: src[m[4]:m[5]]}
+		if arr, ok := jsValue(src[m[1]-1 : end+1]).(jsArray); ok {
+			for _, v := range arr {
+				dm.Values = append(dm.Values, jsString(v))
+			}
+		}
+		out = append(out, dm)
+	}
+	// relations(users, ({ one, many }) => ({ posts: many(posts) }))
+	for _, m := range reDrizzleRels.FindAllStringSubmatchIndex(src, -1) {
+		i, ok := byVar[src[m[2]:m[3]]]
+		if !ok {
+			continue
+		}
+		arrow := strings.Index(src[m[1]:], "=>")
+		if arrow < 0 {
+			continue
+		}
+		open := strings.IndexByte(src[m[1]+arrow:], '{')
+		if open < 0 {
+			continue
+		}
+		open += m[1] + arrow
+		end := matchClose(src, open)
+		if end < 0 {
+			continue
+		}
+		obj, _ := jsValue(src[open : end+1]).(jsObject)
+		for _, p := range obj {
+			rm := reDrizzleOne.FindStringSubmatch(jsString(p.Val))
+			if rm == nil {
+				continue
+			}
+			r := DataRelation{Name: p.Key, Target: rm[2], Type: "one-to-many"}
+			if rm[1] == "one" {
+				r.Type = "one-to-one"
+				if cfg, ok := jsValue(rm[3]).(jsObject); ok {
+					r.Type = "many-to-one"
+					r.Fields = drizzleColumns(cfg, "fields")
+					r.References = drizzleColumns(cfg, "references")
+				}
+			}
+			out[i].Relations = slices.DeleteFunc(out[i].Relations, func(x DataRelation) bool {
+				return x.Target == r.Target && slices.Equal(x.Fields, r.Fields)
+			})
+			out[i].Relations = append(out[i].Relations, r)
+		}
+	}
+	return out
+}
+
+func drizzleColumns(o jsObject, key string) []string {
+	v, _ := o.get(key)
+	arr, _ := v.(jsArray)
+	var out []string
+	for _, e := range arr {
+		s := jsString(e)
+		out = append(out, s[strings.LastIndexByte(s, '.')+1:])
+	}
+	return out
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParsePrisma(t *testing.T) {
+	cases := []struct {
+		name string
+		src  string
+		want string // JSON model pertama
+	}{
+		{
+			name: "fields and attributes",
+			src:  "model User {\n  id    Int     @id @default(autoincrement())\n  email String  @unique\n  name  String? // nama tampilan\n  posts Post[]\n}\n",
+			want: `{"name":"User","kind":"model","source":"prisma","file":"schema.prisma","line":1,"fields":[{"name":"id","type":"Int","primary":true,"default":"autoincrement()"},{"name":"email","type":"String","unique":true},{"name":"name","type":"String","nullable":true},{"name":"posts","type":"Post","list":true}]}`,
+		},
+		{
+			name: "comment markers inside strings",
+			src:  "model Link {\n  id  Int    @id\n  url String @default(\"https://example.com\") // komentar\n  raw String @map(\"a//b\")\n}\n",
+			want: `{"name":"Link","kind":"model","source":"prisma","file":"schema.prisma","line":1,"fields":[{"name":"id","type":"Int","primary":true},{"name":"url","type":"String","default":"\"https://example.com\""},{"name":"raw","type":"String","column":"a//b"}]}`,
+		},
+		{
+			name: "enum",
+			src:  "/// peran user\nenum Role {\n  USER // default\n  ADMIN\n}\n",
+			want: `{"name":"Role","kind":"enum","source":"prisma","file":"schema.prisma","line":2,"values":["USER","ADMIN"]}`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			models := parsePrisma(tc.src, "schema.prisma")
+			if len(models) == 0 {
+				t.Fatal("no models")
+			}
+			got, _ := json.Marshal(models[0])
+			if string(got) != tc.want {
+				t.Errorf("got  %s\nwant %s", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,403 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// ================= dependency graph =================
+
+var (
+	rePHPNamespace = regexp.MustCompile(`(?m)^\s*namespace\s+([\w\\]+)\s*;`)
+	rePHPUse       = regexp.MustCompile(`(?m)^\s*use\s+(?:function\s+|const\s+)?([\w\\]+)(?:\s*\{([^}]*)\})?(?:\s+as\s+\w+)?\s*;`)
+	rePyFromImport = regexp.MustCompile(`^from\s+(\.*)([\w.]*)\s+import\s+(.+)$`)
+	rePyImport     = regexp.MustCompile(`^import\s+(.+)$`)
+)
+
+// depBuilder mengumpulkan edge antar node (file, atau direktori package untuk Go).
+type depBuilder struct {
+	root  string
+	lang  map[string]string
+	edges map[DepEdge]bool
+}
+
+func (b *depBuilder) add(from, to, lang string) {
+	if from == "" || to == "" || from == to {
+		return
+	}
+	b.lang[from], b.lang[to] = lang, lang
+	b.edges[DepEdge{From: from, To: to}] = true
+}
+
+func (b *depBuilder) exists(rel string) bool {
+	fi, err := os.Stat(filepath.Join(b.root, filepath.FromSlash(rel)))
+	return err == nil && !fi.IsDir()
+}
+
+// readDepGraph membangun graph import Go, PHP (PSR-4), JS/TS (relatif + alias tsconfig)
+// dan Python (relatif + modul lokal). format "dot"/"mermaid" menyertakan teks graph.
+func readDepGraph(root string, m *Manifest, format string) *DepGraph {
+	b := &depBuilder{root: root, lang: map[string]string{}, edges: map[DepEdge]bool{}}
+	if m.Go != nil {
+		dirs := map[string]string{}
+		for _, p := range m.Go.Packages {
+			dirs[p.ImportPath] = p.Dir
+		}
+		for _, p := range m.Go.Packages {
+			for _, im := range p.Imports {
+				if d, ok := dirs[im]; ok {
+					b.add(p.Dir, d, "go")
+				}
+			}
+		}
+	}
+	if m.JSOutline != nil {
+		for _, mod := range m.JSOutline.Modules {
+			for _, spec := range mod.Imports {
+				b.add(mod.Path, b.resolveJS(mod.Path, spec, m.JSOutline), "js")
+			}
+		}
+	}
+	var psr4 map[string]string
+	if m.Composer != nil {
+		psr4 = m.Composer.AutoloadPSR4
+	}
+	walkFiles(root, func(full, rel string) {
+		switch {
+		case strings.HasSuffix(rel, ".php") && len(psr4) > 0:
+			src, err := os.ReadFile(full)
+			if err != nil {
+				return
+			}
+			for _, u := range rePHPUse.FindAllStringSubmatch(string(src), -1) {
+				names := []string{u[1]}
+				if u[2] != "" {
+					names = nil
+					for _, n := range strings.Split(u[2], ",") {
+						if n = strings.TrimSpace(strings.Fields(n + " ")[0]); n != "" {
+							names = append(names, strings.TrimSuffix(u[1], "\\")+"\\"+n)
+						}
+					}
+				}
+				for _, fqcn := range names {
+					b.add(rel, b.resolvePHP(fqcn, psr4), "php")
+				}
+			}
+		case strings.HasSuffix(rel, ".py"):
+			for _, l := range readPyLines(full) {
+				for _, target := range b.resolvePy(rel, l.Text) {
+					b.add(rel, target, "python")
+				}
+			}
+		}
+	})
+	if len(b.edges) == 0 {
+		return nil
+	}
+	g := &DepGraph{}
+	files := slices.SortedFunc(func(yield func(DepEdge) bool) {
+		for e := range b.edges {
+			if !yield(e) {
+				return
+			}
+		}
+	}, depEdgeCmp)
+	g.Files = depLevel(files, b.lang, format)
+
+	// agregasi per direktori: Go sudah berupa direktori
+	pkgLang := map[string]string{}
+	pkgEdges := map[DepEdge]bool{}
+	dirOf := func(id string) string {
+		if b.lang[id] == "go" {
+			return id
+		}
+		return path.Dir(id)
+	}
+	for _, e := range files {
+		from, to := dirOf(e.From), dirOf(e.To)
+		pkgLang[from], pkgLang[to] = b.lang[e.From], b.lang[e.To]
+		if from != to {
+			pkgEdges[DepEdge{From: from, To: to}] = true
+		}
+	}
+	var pkgs []DepEdge
+	for e := range pkgEdges {
+		pkgs = append(pkgs, e)
+	}
+	slices.SortFunc(pkgs, depEdgeCmp)
+	g.Packages = depLevel(pkgs, pkgLang, format)
+	return g
+}
+
+func depEdgeCmp(a, b DepEdge) int {
+	return cmp.Or(strings.Compare(a.From, b.From), strings.Compare(a.To, b.To))
+}
+
+// resolvePHP: FQCN -> file lewat prefix PSR-4 terpanjang.
+func (b *depBuilder) resolvePHP(fqcn string, psr4 map[string]string) string {
+	fqcn = strings.TrimPrefix(fqcn, "\\")
+	best := ""
+	for prefix := range psr4 {
+		if strings.HasPrefix(fqcn, prefix) && len(prefix) > len(best) {
+			best = prefix
+		}
+	}
+	if best == "" {
+		return ""
+	}
+	rel := path.Join(strings.TrimSuffix(psr4[best], "/"), strings.ReplaceAll(strings.TrimPrefix(fqcn, best), "\\", "/")+".php")
+	if b.exists(rel) {
+		return rel
+	}
+	return ""
+}
+
+var jsResolveExts = []string{"", ".ts", ".tsx", ".js", ".jsx", ".mjs", ".cjs", ".mts", ".cts", "/index.ts", "/index.tsx", "/index.js", "/index.jsx"}
+
+// resolveJS: specifier relatif, alias compilerOptions.paths, atau relatif baseUrl.
+func (b *depBuilder) resolveJS(from, spec string, o *JSOutline) string {
+	var cands []string
+	switch {
+	case strings.HasPrefix(spec, "."):
+		cands = []string{path.Join(path.Dir(from), spec)}
+	default:
+		base := o.BaseURL
+		if base == "" {
+			base = "."
+		}
+		// seperti TypeScript: pola persis dulu, lalu prefix terpanjang
+		patterns := slices.Sorted(maps.Keys(o.Paths))
+		slices.SortStableFunc(patterns, func(a, b string) int {
+			pa, _, wa := strings.Cut(a, "*")
+			pb, _, wb := strings.Cut(b, "*")
+			if wa != wb {
+				if !wa {
+					return -1
+				}
+				return 1
+			}
+			return len(pb) - len(pa)
+		})
+		for _, pattern := range patterns {
+			targets := o.Paths[pattern]
+			prefix, suffix, wild := strings.Cut(pattern, "*")
+			if !wild && spec != pattern || wild && (!strings.HasPrefix(spec, prefix) || !strings.HasSuffix(spec, suffix) || len(spec) < len(prefix)+len(suffix)) {
+				continue
+			}
+			star := ""
+			if wild {
+				star = spec[len(prefix) : len(spec)-len(suffix)]
+			}
+			for _, t := range targets {
+				cands = append(cands, path.Join(base, strings.Replace(t, "*", star, 1)))
+			}
+		}
+		if o.BaseURL != "" {
+			cands = append(cands, path.Join(o.BaseURL, spec))
+		}
+	}
+	for _, c := range cands {
+		// import './x.js' dari file .ts menunjuk ke x.ts
+		trimmed := strings.TrimSuffix(strings.TrimSuffix(c, ".js"), ".mjs")
+		for _, p := range []string{c, trimmed} {
+			for _, ext := range jsResolveExts {
+				if b.exists(p + ext) {
+					return p + ext
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// resolvePy mengembalikan file lokal yang diimpor satu logical line Python.
+func (b *depBuilder) resolvePy(rel, line string) []string {
+	var out []string
+	module := func(dir, dotted string) string {
+		p := path.Join(dir, strings.ReplaceAll(dotted, ".", "/"))
+		for _, c := range []string{p + ".py", p + "/__init__.py"} {
+			if b.exists(c) {
+				return c
+			}
+		}
+		return ""
+	}
+	// modul absolut dicari dari root dan src/
+	absolute := func(dotted string) string {
+		for _, dir := range []string{".", "src"} {
+			if f := module(dir, dotted); f != "" {
+				return f
+			}
+		}
+		return ""
+	}
+	if m := rePyFromImport.FindStringSubmatch(line); m != nil {
+		names := strings.Trim(m[3], "() ")
+		var base, target string
+		if m[1] != "" {
+			base = path.Dir(rel)
+			for i := 1; i < len(m[1]); i++ {
+				base = path.Dir(base)
+			}
+			if m[2] != "" {
+				target = module(base, m[2])
+			} else if b.exists(path.Join(base, "__init__.py")) {
+				target = path.Join(base, "__init__.py")
+			}
+		} else {
+			target = absolute(m[2])
+		}
+		// `from pkg import sub` bisa menunjuk submodule
+		for _, n := range strings.Split(names, ",") {
+			f := strings.Fields(n)
+			if len(f) == 0 || f[0] == "*" {
+				continue
+			}
+			sub := ""
+			switch {
+			case m[1] != "":
+				sub = module(base, strings.TrimPrefix(m[2]+"."+f[0], "."))
+			default:
+				sub = absolute(m[2] + "." + f[0])
+			}
+			if sub != "" {
+				out = append(out, sub)
+			}
+		}
+		if len(out) == 0 && target != "" {
+			out = append(out, target)
+		}
+		return out
+	}
+	if m := rePyImport.FindStringSubmatch(line); m != nil {
+		for _, n := range strings.Split(m[1], ",") {
+			if f := strings.Fields(n); len(f) > 0 {
+				if t := absolute(f[0]); t != "" {
+					out = append(out, t)
+				}
+			}
+		}
+	}
+	return out
+}
+
+// depLevel menghitung fan-in/fan-out, cycle (SCC Tarjan) dan teks graph.
+func depLevel(edges []DepEdge, lang map[string]string, format string) DepLevel {
+	lv := DepLevel{Edges: edges}
+	idx := map[string]int{}
+	adj := map[string][]string{}
+	for _, e := range edges {
+		for _, id := range []string{e.From, e.To} {
+			if _, ok := idx[id]; !ok {
+				idx[id] = len(lv.Nodes)
+				lv.Nodes = append(lv.Nodes, DepNode{ID: id, Lang: lang[id]})
+			}
+		}
+		lv.Nodes[idx[e.From]].FanOut++
+		lv.Nodes[idx[e.To]].FanIn++
+		adj[e.From] = append(adj[e.From], e.To)
+	}
+	slices.SortFunc(lv.Nodes, func(a, b DepNode) int { return strings.Compare(a.ID, b.ID) })
+
+	lv.Cycles = depCycles(lv.Nodes, adj)
+	inCycle := map[string]int{}
+	for i, c := range lv.Cycles {
+		for _, id := range c {
+			inCycle[id] = i + 1
+		}
+	}
+
+	central := slices.Clone(lv.Nodes)
+	slices.SortStableFunc(central, func(a, b DepNode) int { return b.FanIn - a.FanIn })
+	for _, n := range central[:min(len(central), 10)] {
+		if n.FanIn > 1 {
+			lv.Central = append(lv.Central, n.ID)
+		}
+	}
+
+	if len(edges) == 0 {
+		return lv // tanpa edge: diagram kosong, bukan header saja
+	}
+	switch format {
+	case "dot":
+		var sb strings.Builder
+		sb.WriteString("digraph deps {\n  rankdir=LR;\n  node [shape=box];\n")
+		for _, e := range edges {
+			attr := ""
+			if c := inCycle[e.From]; c > 0 && c == inCycle[e.To] {
+				attr = " [color=red]"
+			}
+			fmt.Fprintf(&sb, "  %q -> %q%s;\n", e.From, e.To, attr)
+		}
+		sb.WriteString("}\n")
+		lv.DOT = sb.String()
+	case "mermaid":
+		var sb strings.Builder
+		sb.WriteString("flowchart LR\n")
+		for i, n := range lv.Nodes {
+			fmt.Fprintf(&sb, "    n%d[\"%s\"]\n", i, strings.ReplaceAll(n.ID, `"`, "'"))
+			idx[n.ID] = i
+		}
+		for _, e := range edges {
+			fmt.Fprintf(&sb, "    n%d --> n%d\n", idx[e.From], idx[e.To])
+		}
+		lv.Mermaid = sb.String()
+	}
+	return lv
+}
+
+// depCycles mengembalikan SCC berukuran > 1 (algoritma Tarjan rekursif, satu DFS per root).
+func depCycles(nodes []DepNode, adj map[string][]string) [][]string {
+	index := map[string]int{}
+	low := map[string]int{}
+	onStack := map[string]bool{}
+	var stack []string
+	var out [][]string
+	next := 0
+	var strong func(v string)
+	strong = func(v string) {
+		index[v], low[v] = next, next
+		next++
+		stack = append(stack, v)
+		onStack[v] = true
+		for _, w := range adj[v] {
+			if _, seen := index[w]; !seen {
+				strong(w)
+				low[v] = min(low[v], low[w])
+			} else if onStack[w] {
+				low[v] = min(low[v], index[w])
+			}
+		}
+		if low[v] != index[v] {
+			return
+		}
+		var comp []string
+		for {
+			w := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[w] = false
+			comp = append(comp, w)
+			if w == v {
+				break
+			}
+		}
+		if len(comp) > 1 {
+			slices.Sort(comp)
+			out = append(out, comp)
+		}
+	}
+	for _, n := range nodes {
+		if _, seen := index[n.ID]; !seen {
+			strong(n.ID)
+		}
+	}
+	slices.SortFunc(out, func(a, b []string) int { return strings.Compare(a[0], b[0]) })
+	return out
+}
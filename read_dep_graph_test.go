@@ -0,0 +1,39 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestResolveJS(t *testing.T) {
+	root := t.TempDir()
+	for _, f := range []string{"src/app.ts", "src/util.ts", "src/components/Button.ts", "lib/components/Button.tsx", "lib/config.ts", "src/config/index.ts"} {
+		full := filepath.Join(root, f)
+		os.MkdirAll(filepath.Dir(full), 0o755)
+		os.WriteFile(full, nil, 0o644)
+	}
+	o := &JSOutline{Paths: map[string][]string{
+		"@/*":            {"src/*"},
+		"@/components/*": {"lib/components/*"},
+		"@/config":       {"lib/config.ts"},
+		"@/conf*":        {"src/conf*"},
+	}}
+	cases := []struct{ spec, want string }{
+		{"./util", "src/util.ts"},
+		{"./util.js", "src/util.ts"},
+		{"@/util", "src/util.ts"},
+		{"@/components/Button", "lib/components/Button.tsx"}, // prefix terpanjang menang
+		{"@/config", "lib/config.ts"},                        // pola persis menang atas wildcard
+		{"@/missing", ""},
+	}
+	b := &depBuilder{root: root}
+	for _, tc := range cases {
+		// ulang beberapa kali: urutan map tidak boleh memengaruhi hasil
+		for range 20 {
+			if got := b.resolveJS("src/app.ts", tc.spec, o); got != tc.want {
+				t.Fatalf("resolveJS(%q) = %q, want %q", tc.spec, got, tc.want)
+			}
+		}
+	}
+}
@@ -0,0 +1,501 @@
+package main
+
+import (
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var reDjangoMigration = regexp.MustCompile(`(^|/)migrations/\d{4}_\w+\.py$`)
+
+var djangoHandlers = map[string]bool{
+	"get": true, "post": true, "put": true, "patch": true, "delete": true, "head": true, "options": true,
+	"list": true, "create": true, "retrieve": true, "update": true, "partial_update": true, "destroy": true,
+}
+
+var djangoRelations = map[string]bool{"ForeignKey": true, "OneToOneField": true, "ManyToManyField": true, "ParentalKey": true, "ParentalManyToManyField": true}
+
+// readDjango mengumpulkan settings, app, model, view, admin dan migration graph Django.
+func readDjango(root string) *DjangoInfo {
+	var settings, migrations []string
+	appDirs := map[string]bool{}
+	files := map[string][]string{} // app dir -> file .py
+	manage := false
+	walkFiles(root, func(full, rel string) {
+		if !strings.HasSuffix(rel, ".py") {
+			return
+		}
+		dir, base := path.Split(rel)
+		dir = strings.TrimSuffix(dir, "/")
+		switch {
+		case base == "manage.py":
+			manage = true
+		case reDjangoMigration.MatchString(rel):
+			migrations = append(migrations, rel)
+			return
+		case base == "settings.py" || path.Base(dir) == "settings":
+			settings = append(settings, rel)
+		case base == "apps.py" || base == "models.py":
+			appDirs[dir] = true
+		case path.Base(dir) == "models" && base == "__init__.py":
+			appDirs[path.Dir(dir)] = true
+		}
+		appDir := dir
+		if b := path.Base(dir); b == "models" || b == "views" {
+			appDir = path.Dir(dir)
+		}
+		files[appDir] = append(files[appDir], rel)
+	})
+	if !manage && len(settings) == 0 {
+		return nil
+	}
+	out := &DjangoInfo{}
+	readDjangoSettings(root, settings, out)
+	if len(out.Settings) == 0 && !manage {
+		return nil
+	}
+
+	for dir := range appDirs {
+		app := DjangoApp{Label: path.Base(dir), Path: dir}
+		if dir == "." || dir == "" {
+			app.Label, app.Path = path.Base(filepath.ToSlash(root)), "."
+		}
+		slices.Sort(files[dir])
+		for _, rel := range files[dir] {
+			full := filepath.Join(root, rel)
+			base := strings.TrimSuffix(path.Base(rel), ".py")
+			parent := path.Base(path.Dir(rel))
+			switch {
+			case base == "apps":
+				app.Name, app.Label = djangoAppConfig(full, app.Label)
+			case base == "models" || parent == "models":
+				app.Models = append(app.Models, djangoModels(full, rel)...)
+			case base == "views" || strings.HasPrefix(base, "views") || base == "viewsets" || parent == "views":
+				app.Views = append(app.Views, djangoViews(full, rel)...)
+			case base == "admin":
+				app.Admin = append(app.Admin, djangoAdmin(full, rel)...)
+			}
+		}
+		out.Apps = append(out.Apps, app)
+	}
+	slices.SortFunc(out.Apps, func(a, b DjangoApp) int { return strings.Compare(a.Path, b.Path) })
+
+	slices.Sort(migrations)
+	for _, rel := range migrations {
+		out.Migrations = append(out.Migrations, djangoMigration(filepath.Join(root, rel), rel))
+	}
+	return out
+}
+
+// djangoAppConfig membaca AppConfig.name/label di apps.py.
+func djangoAppConfig(full, label string) (name, outLabel string) {
+	outLabel = label
+	lines := readPyLines(full)
+	for _, d := range pyDefs(lines, 0, len(lines)) {
+		if d.Kind != "class" {
+			continue
+		}
+		explicit := false
+		for _, a := range pyAssigns(lines, d.Body[0], d.Body[1]) {
+			switch a.Key {
+			case "name":
+				name = pyString(a.Val)
+				if !explicit {
+					outLabel = name[strings.LastIndexByte(name, '.')+1:]
+				}
+			case "label":
+				outLabel = pyString(a.Val)
+				explicit = true
+			}
+		}
+	}
+	return name, outLabel
+}
+
+// readDjangoSettings menggabungkan semua file settings (base dulu, lalu override).
+func readDjangoSettings(root string, files []string, out *DjangoInfo) {
+	slices.SortFunc(files, func(a, b string) int {
+		// settings/base.py / common.py dibaca lebih dulu
+		rank := func(s string) int {
+			switch strings.TrimSuffix(path.Base(s), ".py") {
+			case "__init__", "base", "common", "settings":
+				return 0
+			}
+			return 1
+		}
+		if d := rank(a) - rank(b); d != 0 {
+			return d
+		}
+		return strings.Compare(a, b)
+	})
+	vars := map[string]any{}
+	for _, rel := range files {
+		lines := readPyLines(filepath.Join(root, rel))
+		used := false
+		for _, l := range lines {
+			if l.Indent != 0 {
+				continue
+			}
+			name, expr, ok := pyAssign(l.Text)
+			if !ok {
+				continue
+			}
+			v := pyValue(expr)
+			if strings.HasPrefix(name, "+") {
+				name = name[1:]
+				v = append(djangoList(vars[name], vars), djangoList(v, vars)...)
+			} else if _, ok := v.(pyRaw); ok {
+				// `X = X + [...]` diratakan terhadap binding sebelumnya
+				if l := djangoList(v, vars); l != nil {
+					v = l
+				}
+			}
+			vars[name] = v
+			switch name {
+			case "INSTALLED_APPS":
+				out.InstalledApps = pyStrings(djangoList(v, vars))
+			case "MIDDLEWARE", "MIDDLEWARE_CLASSES":
+				out.Middleware = pyStrings(djangoList(v, vars))
+			case "AUTH_USER_MODEL":
+				out.AuthUserModel = pyString(v)
+			case "ROOT_URLCONF":
+				out.RootURLConf = pyString(v)
+			case "DATABASES":
+				out.Databases = djangoDatabases(v)
+			default:
+				continue
+			}
+			used = true
+		}
+		if used {
+			out.Settings = append(out.Settings, rel)
+		}
+	}
+}
+
+// djangoList meratakan list/tuple settings, termasuk `DJANGO_APPS + LOCAL_APPS`.
+// Nama yang sedang diratakan dilewati agar referensi ke diri sendiri berhenti.
+func djangoList(v any, vars map[string]any) pyList {
+	seen := map[string]bool{}
+	var flat func(v any) pyList
+	lookup := func(name string) (pyList, bool) {
+		known, ok := vars[name]
+		if !ok || seen[name] {
+			return nil, ok
+		}
+		seen[name] = true
+		defer delete(seen, name)
+		return flat(known), true
+	}
+	flat = func(v any) pyList {
+		switch x := v.(type) {
+		case pyList:
+			return x
+		case pyRaw:
+			parts := splitTopLevel(string(x), '+')
+			if len(parts) < 2 {
+				if _, isRaw := vars[strings.TrimSpace(string(x))].(pyRaw); !isRaw {
+					l, _ := lookup(strings.TrimSpace(string(x)))
+					return l
+				}
+				return nil
+			}
+			var out pyList
+			for _, p := range parts {
+				if l, ok := lookup(p); ok {
+					out = append(out, l...)
+				} else {
+					out = append(out, flat(pyValue(p))...)
+				}
+			}
+			return out
+		}
+		return nil
+	}
+	return flat(v)
+}
+
+func djangoDatabases(v any) map[string]DjangoDatabase {
+	d, ok := v.(pyDict)
+	if !ok {
+		return nil
+	}
+	out := map[string]DjangoDatabase{}
+	for _, e := range d {
+		db := DjangoDatabase{}
+		switch x := e.Val.(type) {
+		case pyDict:
+			str := func(k string) string {
+				if v, ok := x.get(k); ok {
+					return pyString(v)
+				}
+				return ""
+			}
+			db.Engine = str("ENGINE")
+			db.Name = str("NAME")
+			db.Host = str("HOST")
+			db.Port = str("PORT")
+			db.User = str("USER")
+		default:
+			// dj_database_url.config(default=...) / env.db("DATABASE_URL")
+			db.URL = pyString(x)
+		}
+		out[e.Key] = db
+	}
+	return out
+}
+
+func djangoModels(full, rel string) []DjangoModel {
+	lines := readPyLines(full)
+	defs := pyDefs(lines, 0, len(lines))
+	isModel := map[string]bool{}
+	for changed := true; changed; {
+		changed = false
+		for _, d := range defs {
+			if d.Kind != "class" || isModel[d.Name] {
+				continue
+			}
+			for _, b := range d.Bases {
+				if strings.Contains(b, "Model") || strings.HasPrefix(b, "Abstract") || isModel[b] {
+					isModel[d.Name] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+	var out []DjangoModel
+	for _, d := range defs {
+		if !isModel[d.Name] {
+			continue
+		}
+		m := DjangoModel{Name: d.Name, File: rel, Line: d.Line, Bases: d.Bases}
+		for _, a := range pyAssigns(lines, d.Body[0], d.Body[1]) {
+			c, ok := a.Val.(pyCall)
+			if !ok {
+				continue
+			}
+			typ := c.Name[strings.LastIndexByte(c.Name, '.')+1:]
+			switch {
+			case strings.HasSuffix(typ, "Manager") || strings.HasSuffix(c.Name, ".as_manager"):
+				m.Managers = append(m.Managers, a.Key)
+			case strings.HasSuffix(typ, "Field") || djangoRelations[typ] || typ == "GenericForeignKey" || typ == "TaggableManager":
+				m.Fields = append(m.Fields, djangoField(a.Key, typ, c, d.Name))
+			}
+		}
+		for _, inner := range pyDefs(lines, d.Body[0], d.Body[1]) {
+			if inner.Kind != "class" || inner.Name != "Meta" {
+				continue
+			}
+			m.Meta = map[string]string{}
+			for _, a := range pyAssigns(lines, inner.Body[0], inner.Body[1]) {
+				m.Meta[a.Key] = pyString(a.Val)
+			}
+			m.Abstract = m.Meta["abstract"] == "True"
+			m.Table = m.Meta["db_table"]
+		}
+		out = append(out, m)
+	}
+	return out
+}
+
+func djangoField(name, typ string, c pyCall, model string) DjangoField {
+	f := DjangoField{Name: name, Type: typ}
+	args := c.Args
+	if djangoRelations[typ] {
+		if to, ok := c.kwarg("to"); ok {
+			f.Related = pyString(to)
+		} else if len(args) > 0 {
+			f.Related = pyString(args[0])
+			args = args[1:]
+		}
+		if f.Related == "self" {
+			f.Related = model
+		}
+		if od, ok := c.kwarg("on_delete"); ok {
+			f.OnDelete = strings.TrimPrefix(pyString(od), "models.")
+		} else if len(args) > 0 {
+			f.OnDelete = strings.TrimPrefix(pyString(args[0]), "models.")
+		}
+	}
+	for _, kw := range c.Kwargs {
+		switch kw.Key {
+		case "to", "on_delete":
+		case "related_name":
+			f.RelatedName = pyString(kw.Val)
+		case "through":
+			f.Through = pyString(kw.Val)
+		case "help_text", "verbose_name":
+		default:
+			if f.Options == nil {
+				f.Options = map[string]string{}
+			}
+			f.Options[kw.Key] = pyString(kw.Val)
+		}
+	}
+	return f
+}
+
+func djangoViews(full, rel string) []DjangoView {
+	lines := readPyLines(full)
+	var out []DjangoView
+	for _, d := range pyDefs(lines, 0, len(lines)) {
+		v := DjangoView{Name: d.Name, File: rel, Line: d.Line, Decorators: d.Decorators}
+		if d.Kind == "def" {
+			first := ""
+			if len(d.Bases) > 0 {
+				first = strings.TrimSpace(strings.SplitN(d.Bases[0], ":", 2)[0])
+			}
+			if first != "request" && len(d.Decorators) == 0 {
+				continue
+			}
+			if strings.HasPrefix(d.Name, "_") {
+				continue
+			}
+			v.Kind = "function"
+			for _, deco := range d.Decorators {
+				// @api_view(['GET', 'POST']) / @require_http_methods([...])
+				if c, ok := pyValue(deco).(pyCall); ok && len(c.Args) > 0 {
+					if strings.HasSuffix(c.Name, "api_view") || strings.HasSuffix(c.Name, "require_http_methods") {
+						v.Methods = pyStrings(c.Args[0])
+					}
+				}
+			}
+			out = append(out, v)
+			continue
+		}
+		view := false
+		for _, b := range d.Bases {
+			if strings.Contains(b, "View") || strings.Contains(b, "Mixin") {
+				view = true
+			}
+		}
+		if !view {
+			continue
+		}
+		v.Kind = "class"
+		v.Bases = d.Bases
+		for _, a := range pyAssigns(lines, d.Body[0], d.Body[1]) {
+			switch a.Key {
+			case "model":
+				v.Model = pyString(a.Val)
+			case "queryset":
+				q := pyString(a.Val)
+				if i := strings.Index(q, ".objects"); i > 0 {
+					v.Model = q[:i]
+				}
+			case "serializer_class":
+				v.Serializer = pyString(a.Val)
+			case "template_name":
+				v.Template = pyString(a.Val)
+			}
+		}
+		for _, m := range pyDefs(lines, d.Body[0], d.Body[1]) {
+			if m.Kind != "def" {
+				continue
+			}
+			action := false
+			for _, deco := range m.Decorators {
+				if strings.HasPrefix(deco, "action") {
+					action = true
+				}
+			}
+			if djangoHandlers[m.Name] || action {
+				v.Methods = append(v.Methods, m.Name)
+			}
+		}
+		out = append(out, v)
+	}
+	return out
+}
+
+func djangoAdmin(full, rel string) []DjangoAdmin {
+	lines := readPyLines(full)
+	var out []DjangoAdmin
+	for _, l := range lines {
+		c, ok := pyValue(l.Text).(pyCall)
+		if !ok || !strings.HasSuffix(c.Name, "site.register") || len(c.Args) == 0 {
+			continue
+		}
+		admin := ""
+		if len(c.Args) > 1 {
+			admin = pyString(c.Args[1])
+		}
+		targets := pyStrings(c.Args[0])
+		if targets == nil {
+			targets = []string{pyString(c.Args[0])}
+		}
+		for _, t := range targets {
+			out = append(out, DjangoAdmin{Model: t, Admin: admin, File: rel})
+		}
+	}
+	for _, d := range pyDefs(lines, 0, len(lines)) {
+		for _, deco := range d.Decorators {
+			c, ok := pyValue(deco).(pyCall)
+			if !ok || !strings.HasSuffix(c.Name, "register") {
+				continue
+			}
+			for _, a := range c.Args {
+				out = append(out, DjangoAdmin{Model: pyString(a), Admin: d.Name, File: rel})
+			}
+		}
+	}
+	return out
+}
+
+func djangoMigration(full, rel string) DjangoMigration {
+	parts := strings.Split(rel, "/")
+	m := DjangoMigration{Name: strings.TrimSuffix(parts[len(parts)-1], ".py")}
+	if len(parts) >= 3 {
+		m.App = parts[len(parts)-3]
+	}
+	lines := readPyLines(full)
+	for _, d := range pyDefs(lines, 0, len(lines)) {
+		if d.Kind != "class" || d.Name != "Migration" {
+			continue
+		}
+		for _, a := range pyAssigns(lines, d.Body[0], d.Body[1]) {
+			switch a.Key {
+			case "initial":
+				m.Initial = a.Val == true
+			case "dependencies":
+				l, _ := a.Val.(pyList)
+				for _, dep := range l {
+					switch x := dep.(type) {
+					case pyList:
+						if len(x) == 2 {
+							m.Dependencies = append(m.Dependencies, pyString(x[0])+"."+pyString(x[1]))
+						}
+					case pyCall:
+						// migrations.swappable_dependency(settings.AUTH_USER_MODEL)
+						if len(x.Args) > 0 {
+							m.Dependencies = append(m.Dependencies, "swappable:"+pyString(x.Args[0]))
+						}
+					}
+				}
+			case "operations":
+				l, _ := a.Val.(pyList)
+				for _, op := range l {
+					c, ok := op.(pyCall)
+					if !ok {
+						continue
+					}
+					s := strings.TrimPrefix(c.Name, "migrations.")
+					if n, ok := c.kwarg("model_name"); ok {
+						s += "(" + pyString(n)
+						if f, ok := c.kwarg("name"); ok {
+							s += "." + pyString(f)
+						}
+						s += ")"
+					} else if n, ok := c.kwarg("name"); ok {
+						s += "(" + pyString(n) + ")"
+					}
+					m.Operations = append(m.Operations, s)
+				}
+			}
+		}
+	}
+	return m
+}
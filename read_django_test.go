@@ -0,0 +1,70 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadDjangoSettings(t *testing.T) {
+	cases := []struct {
+		name  string
+		files map[string]string // path relatif -> isi
+		want  string            // JSON DjangoInfo
+	}{
+		{
+			name:  "plain settings",
+			files: map[string]string{"settings.py": "INSTALLED_APPS = [\n    'django.contrib.admin',\n    'blog',\n]\nMIDDLEWARE = ('a.M',)\nAUTH_USER_MODEL = 'accounts.User'\nROOT_URLCONF = 'site.urls'\n"},
+			want:  `{"settings":["settings.py"],"installed_apps":["django.contrib.admin","blog"],"middleware":["a.M"],"auth_user_model":"accounts.User","root_urlconf":"site.urls"}`,
+		},
+		{
+			name:  "concatenated lists",
+			files: map[string]string{"settings.py": "DJANGO_APPS = ['django.contrib.auth']\nLOCAL_APPS = ['blog']\nINSTALLED_APPS = DJANGO_APPS + LOCAL_APPS + ['extra']\n"},
+			want:  `{"settings":["settings.py"],"installed_apps":["django.contrib.auth","blog","extra"]}`,
+		},
+		{
+			name:  "self reference",
+			files: map[string]string{"settings.py": "INSTALLED_APPS = ['a']\nINSTALLED_APPS = INSTALLED_APPS + ['debug_toolbar']\n"},
+			want:  `{"settings":["settings.py"],"installed_apps":["a","debug_toolbar"]}`,
+		},
+		{
+			name:  "self reference without previous binding",
+			files: map[string]string{"settings.py": "MIDDLEWARE = MIDDLEWARE + ['x.M']\n"},
+			want:  `{"settings":["settings.py"],"middleware":["x.M"]}`,
+		},
+		{
+			name: "augmented assignment across files",
+			files: map[string]string{
+				"settings/base.py": "INSTALLED_APPS = ['a']\n",
+				"settings/dev.py":  "from .base import *\nINSTALLED_APPS += ['debug_toolbar']\nINSTALLED_APPS = INSTALLED_APPS + ['silk']\n",
+			},
+			want: `{"settings":["settings/base.py","settings/dev.py"],"installed_apps":["a","debug_toolbar","silk"]}`,
+		},
+		{
+			name:  "databases",
+			files: map[string]string{"settings.py": "DATABASES = {\n    'default': {\n        'ENGINE': 'django.db.backends.postgresql',\n        'NAME': 'app',\n    }\n}\n"},
+			want:  `{"settings":["settings.py"],"databases":{"default":{"engine":"django.db.backends.postgresql","name":"app"}}}`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			root := t.TempDir()
+			var files []string
+			for rel, src := range tc.files {
+				full := filepath.Join(root, rel)
+				os.MkdirAll(filepath.Dir(full), 0o755)
+				if err := os.WriteFile(full, []byte(src), 0o644); err != nil {
+					t.Fatal(err)
+				}
+				files = append(files, rel)
+			}
+			var info DjangoInfo
+			readDjangoSettings(root, files, &info)
+			got, _ := json.Marshal(info)
+			if string(got) != tc.want {
+				t.Errorf("got  %s\nwant %s", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,308 @@
+package main
+
+import (
+	"slices"
+	"strings"
+)
+
+var eloquentRelationTypes = map[string]bool{
+	"hasOne": true, "hasMany": true, "belongsTo": true, "belongsToMany": true,
+	"hasOneThrough": true, "hasManyThrough": true,
+	"morphTo": true, "morphOne": true, "morphMany": true, "morphToMany": true, "morphedByMany": true,
+}
+
+// parseModel membaca class model Laravel beserta detail Eloquent-nya.
+func parseModel(full string) PHPClassFile {
+	o := readPHPOutline(full)
+	if o == nil {
+		return PHPClassFile{}
+	}
+	pc := o.file
+	pc.Eloquent = eloquentModel(o)
+	return pc
+}
+
+func eloquentModel(o *phpOutline) *EloquentModel {
+	pc := &o.file
+	if pc.Kind != "class" {
+		return nil
+	}
+	em := &EloquentModel{}
+	if v, ok := o.propValue("table"); ok {
+		em.Table = phpString(v)
+	}
+	if em.Table == "" {
+		em.Table = pluralize(snakeCase(pc.Class))
+		em.TableInferred = true
+	}
+	if v, ok := o.propValue("primaryKey"); ok {
+		em.PrimaryKey = phpString(v)
+	}
+	if v, ok := o.propValue("connection"); ok {
+		em.Connection = phpString(v)
+	}
+	if v, ok := o.propValue("fillable"); ok {
+		em.Fillable = phpStrings(v)
+	}
+	if v, ok := o.propValue("guarded"); ok {
+		em.Guarded = phpStrings(v)
+		em.Unguarded = len(em.Guarded) == 0
+	}
+	if v, ok := o.propValue("hidden"); ok {
+		em.Hidden = phpStrings(v)
+	}
+	if v, ok := o.propValue("appends"); ok {
+		em.Appends = phpStrings(v)
+	}
+	if v, ok := o.propValue("casts"); ok {
+		em.Casts = phpStringMap(v)
+	}
+	// Laravel 11+: protected function casts(): array { return [...]; }
+	if v, ok := o.returnValue("casts"); ok {
+		for k, c := range phpStringMap(v) {
+			if em.Casts == nil {
+				em.Casts = map[string]string{}
+			}
+			em.Casts[k] = c
+		}
+	}
+	for _, t := range pc.Traits {
+		if strings.HasSuffix(t, "SoftDeletes") {
+			em.SoftDeletes = true
+		}
+	}
+
+	for _, m := range pc.Methods {
+		name := m.Name
+		switch {
+		case strings.HasPrefix(name, "scope") && len(name) > 5:
+			em.Scopes = append(em.Scopes, lowerFirst(name[5:]))
+			continue
+		case strings.HasPrefix(name, "get") && strings.HasSuffix(name, "Attribute") && len(name) > 12:
+			em.Accessors = append(em.Accessors, snakeCase(name[3:len(name)-9]))
+			continue
+		case strings.HasPrefix(name, "set") && strings.HasSuffix(name, "Attribute") && len(name) > 12:
+			em.Mutators = append(em.Mutators, snakeCase(name[3:len(name)-9]))
+			continue
+		}
+		r, ok := o.bodies[name]
+		if !ok {
+			continue
+		}
+		if m.Returns == "Attribute" || strings.HasSuffix(m.Returns, `\Attribute`) {
+			get, set := o.attributeAccess(r)
+			if get {
+				em.Accessors = append(em.Accessors, snakeCase(name))
+			}
+			if set {
+				em.Mutators = append(em.Mutators, snakeCase(name))
+			}
+			continue
+		}
+		if rel, ok := o.relation(name, r); ok {
+			em.Relations = append(em.Relations, rel)
+		}
+	}
+	return em
+}
+
+// attributeAccess mendeteksi get:/set: pada Attribute::make(...) atau Attribute::get/set.
+func (o *phpOutline) attributeAccess(r [2]int) (get, set bool) {
+	for k := r[0]; k < r[1]; k++ {
+		t := o.toks[k]
+		if t.kind != 'n' {
+			continue
+		}
+		next := o.toks[k+1].val
+		prev := ""
+		if k > 0 {
+			prev = o.toks[k-1].val
+		}
+		switch {
+		case t.val == "get" && (next == ":" || (prev == "::" && next == "(")):
+			get = true
+		case t.val == "set" && (next == ":" || (prev == "::" && next == "(")):
+			set = true
+		}
+	}
+	return
+}
+
+// relation mencari `$this->hasMany(Order::class, ...)` di body method.
+func (o *phpOutline) relation(method string, r [2]int) (EloquentRelation, bool) {
+	toks := o.toks
+	for k := r[0]; k+3 <= r[1]; k++ {
+		if toks[k].kind != 'v' || toks[k].val != "this" || toks[k+1].val != "->" || !eloquentRelationTypes[toks[k+2].val] || toks[k+3].val != "(" {
+			continue
+		}
+		rel := EloquentRelation{Name: method, Type: toks[k+2].val}
+		close := o.skipBalanced(k+3, "(", ")")
+		var args []string
+		for _, part := range o.splitComma(k+4, close-1) {
+			args = append(args, phpString(o.value(part[0], part[1])))
+		}
+		arg := func(i int) string {
+			if i < len(args) {
+				return args[i]
+			}
+			return ""
+		}
+		switch rel.Type {
+		case "morphTo":
+			rel.Morph = arg(0)
+			if rel.Morph == "" {
+				rel.Morph = snakeCase(method)
+			}
+			rel.Keys = slices.Clone(args[min(1, len(args)):])
+		case "morphOne", "morphMany":
+			rel.Related = arg(0)
+			rel.Morph = arg(1)
+		case "morphToMany", "morphedByMany":
+			rel.Related = arg(0)
+			rel.Morph = arg(1)
+			rel.Pivot = arg(2)
+			if rel.Pivot == "" && rel.Morph != "" {
+				rel.Pivot = pluralize(rel.Morph)
+			}
+		case "belongsToMany":
+			rel.Related = arg(0)
+			rel.Pivot = arg(1)
+			if rel.Pivot == "" && rel.Related != "" {
+				pair := []string{snakeCase(o.file.Class), snakeCase(phpBaseName(rel.Related))}
+				slices.Sort(pair)
+				rel.Pivot = strings.Join(pair, "_")
+			}
+			if len(args) > 2 {
+				rel.Keys = slices.Clone(args[2:])
+			}
+		case "hasOneThrough", "hasManyThrough":
+			rel.Related = arg(0)
+			rel.Through = arg(1)
+			if len(args) > 2 {
+				rel.Keys = slices.Clone(args[2:])
+			}
+		default:
+			rel.Related = arg(0)
+			if len(args) > 1 {
+				rel.Keys = slices.Clone(args[1:])
+			}
+		}
+		// ->using(CustomPivot::class)
+		for c := close + 1; c+3 <= r[1] && toks[c].val == "->"; {
+			if toks[c+1].val == "using" && toks[c+2].val == "(" {
+				end := o.skipBalanced(c+2, "(", ")")
+				rel.Using = phpString(o.value(c+3, end-1))
+				break
+			}
+			if toks[c+2].val != "(" {
+				break
+			}
+			c = o.skipBalanced(c+2, "(", ")") + 1
+		}
+		return rel, true
+	}
+	return EloquentRelation{}, false
+}
+
+// linkEloquentRelations mengisi path model target setiap relasi.
+func linkEloquentRelations(models []PHPClassFile) {
+	byFQCN := map[string]string{}
+	byBase := map[string]string{}
+	for _, m := range models {
+		if m.Class == "" {
+			continue
+		}
+		fq := m.Class
+		if m.Namespace != "" {
+			fq = m.Namespace + `\` + m.Class
+		}
+		byFQCN[fq] = m.Path
+		byBase[m.Class] = m.Path
+	}
+	for i := range models {
+		em := models[i].Eloquent
+		if em == nil {
+			continue
+		}
+		for j := range em.Relations {
+			rel := &em.Relations[j]
+			if rel.Related == "" {
+				continue
+			}
+			if p, ok := byFQCN[strings.TrimPrefix(rel.Related, `\`)]; ok {
+				rel.Model = p
+			} else if p, ok := byBase[phpBaseName(rel.Related)]; ok {
+				rel.Model = p
+			}
+		}
+	}
+}
+
+func phpBaseName(fqcn string) string {
+	if i := strings.LastIndex(fqcn, `\`); i >= 0 {
+		return fqcn[i+1:]
+	}
+	return fqcn
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+// snakeCase mengikuti Str::snake Laravel: OrderItem -> order_item.
+func snakeCase(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= 'A' && c <= 'Z' {
+			if i > 0 && s[i-1] != '_' {
+				b.WriteByte('_')
+			}
+			b.WriteByte(c + 32)
+			continue
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+var irregularPlurals = map[string]string{
+	"person": "people", "child": "children", "man": "men", "woman": "women",
+	"mouse": "mice", "goose": "geese", "foot": "feet", "tooth": "teeth",
+	"datum": "data", "criterion": "criteria", "medium": "media",
+	// f/fe -> ves hanya untuk kata yang dikenal (roof, chief, proof, cafe tetap + s)
+	"leaf": "leaves", "half": "halves", "calf": "calves", "wolf": "wolves", "thief": "thieves",
+	"loaf": "loaves", "self": "selves", "elf": "elves", "sheaf": "sheaves", "shelf": "shelves",
+	"knife": "knives", "life": "lives", "wife": "wives",
+}
+
+var uncountables = map[string]bool{
+	"equipment": true, "information": true, "rice": true, "money": true, "species": true,
+	"series": true, "fish": true, "sheep": true, "news": true, "data": true, "media": true, "feedback": true,
+}
+
+// pluralize memberi bentuk jamak bahasa Inggris sederhana untuk kata terakhir snake_case.
+func pluralize(s string) string {
+	head, last := "", s
+	if i := strings.LastIndexByte(s, '_'); i >= 0 {
+		head, last = s[:i+1], s[i+1:]
+	}
+	lw := strings.ToLower(last)
+	if uncountables[lw] {
+		return s
+	}
+	if p, ok := irregularPlurals[lw]; ok {
+		return head + p
+	}
+	switch {
+	case strings.HasSuffix(lw, "y") && len(lw) > 1 && !strings.ContainsRune("aeiou", rune(lw[len(lw)-2])):
+		return head + last[:len(last)-1] + "ies"
+	case strings.HasSuffix(lw, "s") || strings.HasSuffix(lw, "x") || strings.HasSuffix(lw, "z") ||
+		strings.HasSuffix(lw, "ch") || strings.HasSuffix(lw, "sh"):
+		return head + last + "es"
+	}
+	return head + last + "s"
+}
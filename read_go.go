@@ -1,53 +1,198 @@
 package main
 
 import (
-	"bufio"
-	"bytes"
 	"os"
 	"path/filepath"
 	"strings"
 )
 
+// goModLine adalah satu direktif go.mod/go.work (baris di dalam blok mewarisi verb-nya).
+type goModLine struct {
+	Verb    string
+	Args    []string
+	Comment string
+}
+
+// parseGoModFile memecah go.mod/go.work menjadi direktif; komentar // disimpan terpisah.
+func parseGoModFile(src string) []goModLine {
+	var out []goModLine
+	block := ""
+	for _, raw := range strings.Split(src, "\n") {
+		toks, comment := goModTokens(raw)
+		switch {
+		case len(toks) == 0:
+			continue
+		case block != "" && toks[0] == ")":
+			block = ""
+			continue
+		case block != "":
+			out = append(out, goModLine{Verb: block, Args: toks, Comment: comment})
+		case len(toks) == 2 && toks[1] == "(":
+			block = toks[0]
+		default:
+			out = append(out, goModLine{Verb: toks[0], Args: toks[1:], Comment: comment})
+		}
+	}
+	return out
+}
+
+// goModTokens memecah satu baris dengan dukungan string "..." dan `...`.
+func goModTokens(line string) ([]string, string) {
+	var toks []string
+	for i := 0; i < len(line); {
+		c := line[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+		case strings.HasPrefix(line[i:], "//"):
+			return toks, strings.TrimSpace(line[i+2:])
+		case c == '"' || c == '`':
+			j := i + 1
+			for j < len(line) && line[j] != c {
+				if c == '"' && line[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			toks = append(toks, line[i+1:min(j, len(line))])
+			i = j + 1
+		case c == '(' || c == ')':
+			toks = append(toks, string(c))
+			i++
+		default:
+			j := i
+			for j < len(line) && !strings.ContainsRune(" \t\r()\"`", rune(line[j])) && !strings.HasPrefix(line[j:], "//") {
+				j++
+			}
+			toks = append(toks, line[i:j])
+			i = j
+		}
+	}
+	return toks, ""
+}
+
 func readGoModule(root string) *GoInfo {
-	f := filepath.Join(root, "go.mod")
-	b, err := os.ReadFile(f)
+	b, err := os.ReadFile(filepath.Join(root, "go.mod"))
 	if err != nil {
+		if work := readGoWork(root); work != nil {
+			return &GoInfo{Work: work}
+		}
 		return nil
 	}
-	mod := ""
+	g := &GoInfo{}
 	var req []string
-	sc := bufio.NewScanner(bytes.NewReader(b))
-	inReqBlock := false
-	for sc.Scan() {
-		line := strings.TrimSpace(sc.Text())
-		if strings.HasPrefix(line, "module ") {
-			mod = strings.TrimSpace(strings.TrimPrefix(line, "module"))
-		}
-		if strings.HasPrefix(line, "require (") {
-			inReqBlock = true
-			continue
-		}
-		if inReqBlock && strings.HasPrefix(line, ")") {
-			inReqBlock = false
-			continue
-		}
-		if inReqBlock {
-			fields := strings.Fields(line)
-			if len(fields) >= 1 {
-				req = append(req, fields[0])
+	for _, l := range parseGoModFile(string(b)) {
+		switch l.Verb {
+		case "module":
+			if len(l.Args) > 0 {
+				g.Module = l.Args[0]
 			}
-		}
-		if strings.HasPrefix(line, "require ") && !strings.HasPrefix(line, "require (") {
-			parts := strings.Fields(strings.TrimPrefix(line, "require "))
-			if len(parts) >= 1 {
-				req = append(req, parts[0])
+		case "go":
+			if len(l.Args) > 0 {
+				g.GoVersion = l.Args[0]
+			}
+		case "toolchain":
+			if len(l.Args) > 0 {
+				g.Toolchain = l.Args[0]
+			}
+		case "require":
+			if len(l.Args) >= 2 {
+				req = append(req, l.Args[0])
+				g.Require = append(g.Require, GoRequire{Path: l.Args[0], Version: l.Args[1], Indirect: goIndirect(l.Comment)})
+			}
+		case "exclude":
+			if len(l.Args) >= 2 {
+				g.Exclude = append(g.Exclude, GoModVersion{Path: l.Args[0], Version: l.Args[1]})
+			}
+		case "retract":
+			g.Retract = append(g.Retract, strings.Join(l.Args, " "))
+		case "replace":
+			if r, ok := goReplace(root, l.Args); ok {
+				g.Replace = append(g.Replace, r)
 			}
 		}
 	}
-	if mod == "" && len(req) == 0 {
+	g.Requires = unique(req)
+	g.Work = readGoWork(root)
+	if g.Module == "" && len(g.Require) == 0 {
 		return nil
 	}
-	return &GoInfo{Module: mod, Requires: unique(req)}
+	return g
+}
+
+// goIndirect: komentar "// indirect" atau "// indirect; alasan".
+func goIndirect(comment string) bool {
+	return comment == "indirect" || strings.HasPrefix(comment, "indirect;")
+}
+
+// goReplace membaca "old [v] => new [v]"; target lokal ditautkan ke module di go.mod-nya.
+func goReplace(root string, args []string) (GoReplace, bool) {
+	i := 0
+	for i < len(args) && args[i] != "=>" {
+		i++
+	}
+	if i == 0 || i >= len(args)-1 {
+		return GoReplace{}, false
+	}
+	r := GoReplace{Old: args[0], New: args[i+1]}
+	if i > 1 {
+		r.OldVersion = args[1]
+	}
+	if len(args) > i+2 {
+		r.NewVersion = args[i+2]
+	}
+	if hasAnyPrefix(r.New, "./", "../", "/") || filepath.IsAbs(r.New) {
+		r.Local = true
+		r.Module, r.Dir = goLocalModule(root, r.New)
+	}
+	return r, true
+}
+
+// goLocalModule mengembalikan module path di dir/go.mod dan dir relatif root (kosong bila di luar).
+func goLocalModule(root, dir string) (module, rel string) {
+	full := dir
+	if !filepath.IsAbs(full) {
+		full = filepath.Join(root, filepath.FromSlash(dir))
+	}
+	if b, err := os.ReadFile(filepath.Join(full, "go.mod")); err == nil {
+		if m := reGoModuleLine.FindSubmatch(b); m != nil {
+			module = string(m[1])
+		}
+	}
+	if r, err := filepath.Rel(root, full); err == nil && !strings.HasPrefix(filepath.ToSlash(r), "../") && r != ".." {
+		rel = filepath.ToSlash(r)
+	}
+	return module, rel
+}
+
+func readGoWork(root string) *GoWork {
+	b, err := os.ReadFile(filepath.Join(root, "go.work"))
+	if err != nil {
+		return nil
+	}
+	w := &GoWork{}
+	for _, l := range parseGoModFile(string(b)) {
+		switch l.Verb {
+		case "go":
+			if len(l.Args) > 0 {
+				w.GoVersion = l.Args[0]
+			}
+		case "toolchain":
+			if len(l.Args) > 0 {
+				w.Toolchain = l.Args[0]
+			}
+		case "use":
+			if len(l.Args) > 0 {
+				mod, _ := goLocalModule(root, l.Args[0])
+				w.Use = append(w.Use, GoWorkUse{Dir: l.Args[0], Module: mod})
+			}
+		case "replace":
+			if r, ok := goReplace(root, l.Args); ok {
+				w.Replace = append(w.Replace, r)
+			}
+		}
+	}
+	return w
 }
 
 func goHas(g *GoInfo, pkgs []string) bool {
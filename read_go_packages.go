@@ -0,0 +1,267 @@
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var reGoModuleLine = regexp.MustCompile(`(?m)^\s*module\s+"?([^\s"]+)"?`)
+
+// readGoPackages membuat outline setiap package Go (tanpa compile) memakai go/parser.
+func readGoPackages(root string) []GoPackage {
+	modules := map[string]string{} // dir rel -> module path
+	dirs := map[string][]string{}  // dir rel -> file rel
+	walkFiles(root, func(full, rel string) {
+		dir := path.Dir(rel)
+		if goIgnoredDir(dir) {
+			return
+		}
+		switch {
+		case path.Base(rel) == "go.mod":
+			if b, err := os.ReadFile(full); err == nil {
+				if m := reGoModuleLine.FindSubmatch(b); m != nil {
+					modules[dir] = string(m[1])
+				}
+			}
+		case strings.HasSuffix(rel, ".go"):
+			dirs[dir] = append(dirs[dir], rel)
+		}
+	})
+	var out []GoPackage
+	for dir, files := range dirs {
+		if p, ok := goPackage(root, dir, files, goImportPath(modules, dir)); ok {
+			out = append(out, p)
+		}
+	}
+	slices.SortFunc(out, func(a, b GoPackage) int { return strings.Compare(a.ImportPath, b.ImportPath) })
+	return out
+}
+
+// goIgnoredDir mengikuti aturan go tool: testdata, _x dan .x diabaikan.
+func goIgnoredDir(dir string) bool {
+	if dir == "." {
+		return false
+	}
+	for _, seg := range strings.Split(dir, "/") {
+		if seg == "testdata" || strings.HasPrefix(seg, "_") || strings.HasPrefix(seg, ".") {
+			return true
+		}
+	}
+	return false
+}
+
+// goImportPath mencari go.mod terdekat di atas dir.
+func goImportPath(modules map[string]string, dir string) string {
+	for d := dir; ; d = path.Dir(d) {
+		if mod, ok := modules[d]; ok {
+			if d == dir {
+				return mod
+			}
+			return mod + "/" + strings.TrimPrefix(dir, d+"/")
+		}
+		if d == "." || d == "/" {
+			return dir
+		}
+	}
+}
+
+func goPackage(root, dir string, files []string, importPath string) (GoPackage, bool) {
+	p := GoPackage{ImportPath: importPath, Dir: dir}
+	fset := token.NewFileSet()
+	names := map[string]int{}
+	types := map[string]int{} // nama type -> index di p.Types
+	var methods []*ast.FuncDecl
+	var imports []string
+	slices.Sort(files)
+	for _, rel := range files {
+		src, err := os.ReadFile(filepath.Join(root, filepath.FromSlash(rel)))
+		if err != nil {
+			continue
+		}
+		if strings.HasSuffix(rel, "_test.go") {
+			p.TestFiles++
+			f, err := parser.ParseFile(fset, rel, src, parser.SkipObjectResolution)
+			if err != nil {
+				continue
+			}
+			for _, d := range f.Decls {
+				if fd, ok := d.(*ast.FuncDecl); ok && fd.Recv == nil && hasAnyPrefix(fd.Name.Name, "Test", "Benchmark", "Fuzz", "Example") {
+					p.Tests++
+				}
+			}
+			continue
+		}
+		f, err := parser.ParseFile(fset, rel, src, parser.ParseComments|parser.SkipObjectResolution)
+		if err != nil || goBuildIgnored(f) {
+			continue
+		}
+		p.Files++
+		names[f.Name.Name]++
+		if f.Doc != nil && p.Doc == "" {
+			p.Doc = goSynopsis(f.Doc)
+		}
+		for _, im := range f.Imports {
+			imports = append(imports, strings.Trim(im.Path.Value, "\"`"))
+		}
+		base := path.Base(rel)
+		for _, d := range f.Decls {
+			switch d := d.(type) {
+			case *ast.GenDecl:
+				if d.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range d.Specs {
+					ts := spec.(*ast.TypeSpec)
+					if !ts.Name.IsExported() {
+						continue
+					}
+					doc := ts.Doc
+					if doc == nil && len(d.Specs) == 1 {
+						doc = d.Doc
+					}
+					t := GoType{Name: ts.Name.Name, File: base, Line: fset.Position(ts.Pos()).Line, Doc: goSynopsis(doc)}
+					goTypeBody(fset, ts, &t)
+					types[t.Name] = len(p.Types)
+					p.Types = append(p.Types, t)
+				}
+			case *ast.FuncDecl:
+				if !d.Name.IsExported() {
+					continue
+				}
+				if d.Recv != nil {
+					methods = append(methods, d)
+					continue
+				}
+				p.Funcs = append(p.Funcs, GoFunc{Name: d.Name.Name, Signature: goSignature(fset, d), File: base, Line: fset.Position(d.Pos()).Line, Doc: goSynopsis(d.Doc)})
+			}
+		}
+	}
+	if p.Files == 0 {
+		return p, false
+	}
+	// nama package mayoritas (file dengan build tag lain bisa berbeda)
+	for n, c := range names {
+		if c > names[p.Name] || (c == names[p.Name] && n < p.Name) {
+			p.Name = n
+		}
+	}
+	if p.Name == "main" {
+		p.Main = true
+		p.Binary = path.Base(importPath)
+	}
+	// method exported ditempel ke type penerimanya
+	for _, fd := range methods {
+		if i, ok := types[goRecvType(fd.Recv.List[0].Type)]; ok {
+			p.Types[i].Methods = append(p.Types[i].Methods, strings.TrimPrefix(goSignature(fset, fd), "func "))
+			p.Types[i].methods = append(p.Types[i].methods, GoFunc{Name: fd.Name.Name, File: path.Base(fset.Position(fd.Pos()).Filename), Line: fset.Position(fd.Pos()).Line})
+		}
+	}
+	slices.Sort(imports)
+	p.Imports = slices.Compact(imports)
+	return p, true
+}
+
+// goBuildIgnored true untuk file dengan constraint `//go:build ignore`.
+func goBuildIgnored(f *ast.File) bool {
+	for _, cg := range f.Comments {
+		if cg.Pos() > f.Package {
+			break
+		}
+		for _, c := range cg.List {
+			if strings.HasPrefix(c.Text, "//go:build") && strings.TrimSpace(strings.TrimPrefix(c.Text, "//go:build")) == "ignore" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func goTypeBody(fset *token.FileSet, ts *ast.TypeSpec, t *GoType) {
+	switch x := ts.Type.(type) {
+	case *ast.StructType:
+		t.Kind = "struct"
+		for _, f := range x.Fields.List {
+			typ := goExpr(fset, f.Type)
+			if len(f.Names) == 0 {
+				t.Fields = append(t.Fields, typ) // embedded
+				continue
+			}
+			for _, n := range f.Names {
+				if n.IsExported() {
+					t.Fields = append(t.Fields, n.Name+" "+typ)
+				}
+			}
+		}
+	case *ast.InterfaceType:
+		t.Kind = "interface"
+		for _, m := range x.Methods.List {
+			if len(m.Names) == 0 {
+				t.Methods = append(t.Methods, goExpr(fset, m.Type)) // embedded / type set
+				continue
+			}
+			sig := strings.TrimPrefix(goExpr(fset, m.Type), "func")
+			for _, n := range m.Names {
+				t.Methods = append(t.Methods, n.Name+sig)
+			}
+		}
+	case *ast.FuncType:
+		t.Kind = "func"
+	default:
+		t.Kind = goExpr(fset, ts.Type)
+	}
+	if ts.Assign.IsValid() {
+		t.Kind = "alias"
+	}
+}
+
+// goSignature mencetak deklarasi func tanpa body dan doc.
+func goSignature(fset *token.FileSet, fd *ast.FuncDecl) string {
+	cp := *fd
+	cp.Body, cp.Doc = nil, nil
+	return goExpr(fset, &cp)
+}
+
+func goExpr(fset *token.FileSet, n ast.Node) string {
+	var b bytes.Buffer
+	printer.Fprint(&b, fset, n)
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+func goRecvType(e ast.Expr) string {
+	switch x := e.(type) {
+	case *ast.StarExpr:
+		return goRecvType(x.X)
+	case *ast.IndexExpr:
+		return goRecvType(x.X)
+	case *ast.IndexListExpr:
+		return goRecvType(x.X)
+	case *ast.Ident:
+		return x.Name
+	}
+	return ""
+}
+
+// goSynopsis mengambil kalimat pertama dari doc comment.
+func goSynopsis(cg *ast.CommentGroup) string {
+	if cg == nil {
+		return ""
+	}
+	text := cg.Text()
+	if i := strings.Index(text, "\n\n"); i >= 0 {
+		text = text[:i]
+	}
+	text = strings.Join(strings.Fields(text), " ")
+	if i := strings.Index(text, ". "); i >= 0 {
+		text = text[:i+1]
+	}
+	return truncate(text, 200)
+}
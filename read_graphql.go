@@ -0,0 +1,782 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var (
+	// Apollo / graphql-tag: gql`...`, graphql`...`, typeDefs = `...`
+	reGqlTagged   = regexp.MustCompile("(?s)\\b(?:gql|graphql)\\s*`([^`]*)`")
+	reGqlTypeDefs = regexp.MustCompile("(?s)\\btypeDefs\\s*[:=]\\s*`([^`]*)`")
+	reGqlInterp   = regexp.MustCompile(`\$\{[^}]*\}`)
+	reGqlResolver = regexp.MustCompile(`\b([A-Z]\w*)\s*:\s*\{`)
+
+	// gqlgen: func (r *queryResolver) Users(ctx context.Context, ...)
+	reGqlgenResolver = regexp.MustCompile(`(?m)^func\s*\(\s*\w+\s+\*(\w+)Resolver\s*\)\s*(\w+)\s*\(`)
+
+	// graphene
+	rePyClass    = regexp.MustCompile(`^class\s+(\w+)\s*(?:\((.*)\))?\s*:`)
+	rePyAssign   = regexp.MustCompile(`^(\w+)\s*=\s*(.+)$`)
+	rePyResolve  = regexp.MustCompile(`^def\s+resolve_(\w+)\s*\(`)
+	reGrapheneFn = regexp.MustCompile(`^(?:graphene\.)?(\w+)(?:\.Field)?\s*\((.*)\)\s*$`)
+
+	// Lighthouse: @field(resolver: "App\\GraphQL\\Queries\\Foo@bar")
+	reLighthouseResolver = regexp.MustCompile(`resolver:\s*"([^"]+)"`)
+)
+
+func readGraphQL(root string) *GraphQLInfo {
+	c := &gqlCollector{
+		types:     map[string]*GraphQLType{},
+		roots:     map[string]string{"query": "Query", "mutation": "Mutation", "subscription": "Subscription"},
+		resolvers: map[string]string{},
+	}
+	var files []string
+	walkFiles(root, func(full, rel string) {
+		ext := strings.ToLower(filepath.Ext(rel))
+		switch ext {
+		case ".graphql", ".gql", ".graphqls":
+			b, err := os.ReadFile(full)
+			if err != nil {
+				return
+			}
+			c.parseSDL(string(b), rel)
+			files = append(files, rel)
+		case ".js", ".jsx", ".ts", ".tsx", ".mjs", ".cjs":
+			b, err := os.ReadFile(full)
+			if err != nil {
+				return
+			}
+			text := string(b)
+			found := false
+			for _, re := range []*regexp.Regexp{reGqlTagged, reGqlTypeDefs} {
+				for _, m := range re.FindAllStringSubmatch(text, -1) {
+					if c.parseSDL(reGqlInterp.ReplaceAllString(m[1], " "), rel) {
+						found = true
+					}
+				}
+			}
+			if strings.Contains(text, "resolvers") || strings.Contains(text, "Resolvers") {
+				if c.collectJSResolvers(text, rel) {
+					found = true
+				}
+			}
+			if found {
+				files = append(files, rel)
+			}
+		case ".go":
+			if !strings.HasSuffix(rel, ".resolvers.go") {
+				return
+			}
+			b, err := os.ReadFile(full)
+			if err != nil {
+				return
+			}
+			for _, m := range reGqlgenResolver.FindAllStringSubmatch(string(b), -1) {
+				c.addResolver(upperFirst(m[1]), m[2], rel)
+			}
+			files = append(files, rel)
+		case ".py":
+			b, err := os.ReadFile(full)
+			if err != nil || !strings.Contains(string(b), "graphene") {
+				return
+			}
+			if c.parseGraphene(string(b), rel) {
+				files = append(files, rel)
+			}
+		}
+	})
+	if len(c.order) == 0 {
+		return nil
+	}
+	slices.Sort(files)
+	return c.finish(root, unique(files))
+}
+
+type gqlCollector struct {
+	types     map[string]*GraphQLType
+	order     []string
+	roots     map[string]string // operation -> root type name
+	resolvers map[string]string // "Type.field" (normalized) -> file
+}
+
+func (c *gqlCollector) typ(name, kind, file string) *GraphQLType {
+	t, ok := c.types[name]
+	if !ok {
+		t = &GraphQLType{Name: name, Kind: kind, File: file}
+		c.types[name] = t
+		c.order = append(c.order, name)
+	}
+	if t.Kind == "" {
+		t.Kind = kind
+	}
+	return t
+}
+
+func (c *gqlCollector) addResolver(typ, field, file string) {
+	k := typ + "." + normGqlName(field)
+	if _, ok := c.resolvers[k]; !ok {
+		c.resolvers[k] = file
+	}
+}
+
+func normGqlName(s string) string {
+	return strings.ToLower(strings.ReplaceAll(s, "_", ""))
+}
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+func (c *gqlCollector) finish(root string, files []string) *GraphQLInfo {
+	out := &GraphQLInfo{Files: files}
+	ops := map[string]string{}
+	for op, name := range c.roots {
+		ops[name] = op
+	}
+	slices.Sort(c.order)
+	for _, name := range c.order {
+		t := c.types[name]
+		for i := range t.Fields {
+			f := &t.Fields[i]
+			if f.Resolver == "" {
+				// @field(resolver:) eksplisit menang dari tebakan lain
+				f.Resolver = lighthouseResolver(root, "", f)
+			}
+			if f.Resolver == "" {
+				f.Resolver = c.resolvers[name+"."+normGqlName(f.Name)]
+			}
+			if f.Resolver == "" {
+				f.Resolver = lighthouseResolver(root, ops[name], f)
+			}
+		}
+		switch ops[name] {
+		case "query":
+			out.Queries = append(out.Queries, t.Fields...)
+		case "mutation":
+			out.Mutations = append(out.Mutations, t.Fields...)
+		case "subscription":
+			out.Subscriptions = append(out.Subscriptions, t.Fields...)
+		default:
+			out.Types = append(out.Types, *t)
+		}
+	}
+	return out
+}
+
+// lighthouseResolver menebak file resolver Lighthouse: @field(resolver:) atau konvensi app/GraphQL/{Queries,Mutations}.
+func lighthouseResolver(root, op string, f *GraphQLField) string {
+	for _, d := range f.Directives {
+		if m := reLighthouseResolver.FindStringSubmatch(d); len(m) == 2 {
+			cls := strings.ReplaceAll(m[1], `\\`, `\`)
+			if i := strings.IndexByte(cls, '@'); i >= 0 {
+				cls = cls[:i]
+			}
+			if rel := phpClassToPath(cls); rel != "" && exists(filepath.Join(root, rel)) {
+				return rel
+			}
+		}
+	}
+	dir := ""
+	switch op {
+	case "query":
+		dir = "app/GraphQL/Queries/"
+	case "mutation":
+		dir = "app/GraphQL/Mutations/"
+	case "subscription":
+		dir = "app/GraphQL/Subscriptions/"
+	default:
+		return ""
+	}
+	rel := dir + upperFirst(f.Name) + ".php"
+	if exists(filepath.Join(root, rel)) {
+		return rel
+	}
+	return ""
+}
+
+// phpClassToPath memetakan FQCN App\Foo\Bar ke app/Foo/Bar.php (konvensi Laravel).
+func phpClassToPath(fqcn string) string {
+	fqcn = strings.TrimPrefix(fqcn, `\`)
+	if !strings.HasPrefix(fqcn, `App\`) {
+		return ""
+	}
+	return "app/" + strings.ReplaceAll(strings.TrimPrefix(fqcn, `App\`), `\`, "/") + ".php"
+}
+
+// ================= SDL =================
+
+type gqlTok struct {
+	kind byte // n=name s=string d=number p=punct
+	val  string
+}
+
+func lexGraphQL(src string) []gqlTok {
+	var toks []gqlTok
+	for i := 0; i < len(src); {
+		ch := src[i]
+		switch {
+		case ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r' || ch == ',' || ch == 0xEF || ch == 0xBB || ch == 0xBF:
+			i++
+		case ch == '#':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(src[i:], `"""`):
+			end := strings.Index(src[i+3:], `"""`)
+			if end < 0 {
+				return toks
+			}
+			toks = append(toks, gqlTok{'s', src[i+3 : i+3+end]})
+			i += end + 6
+		case ch == '"':
+			j := i + 1
+			for j < len(src) && src[j] != '"' && src[j] != '\n' {
+				if src[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			toks = append(toks, gqlTok{'s', src[i+1 : min(j, len(src))]})
+			i = j + 1
+		case ch == '_' || (ch|0x20 >= 'a' && ch|0x20 <= 'z'):
+			j := i + 1
+			for j < len(src) && (src[j] == '_' || (src[j]|0x20 >= 'a' && src[j]|0x20 <= 'z') || (src[j] >= '0' && src[j] <= '9')) {
+				j++
+			}
+			toks = append(toks, gqlTok{'n', src[i:j]})
+			i = j
+		case ch == '-' || (ch >= '0' && ch <= '9'):
+			j := i + 1
+			for j < len(src) && strings.IndexByte("0123456789.eE+-", src[j]) >= 0 {
+				j++
+			}
+			toks = append(toks, gqlTok{'d', src[i:j]})
+			i = j
+		case strings.HasPrefix(src[i:], "..."):
+			toks = append(toks, gqlTok{'p', "..."})
+			i += 3
+		default:
+			toks = append(toks, gqlTok{'p', string(ch)})
+			i++
+		}
+	}
+	return toks
+}
+
+type sdlParser struct {
+	toks []gqlTok
+	i    int
+}
+
+func (p *sdlParser) eof() bool { return p.i >= len(p.toks) }
+
+func (p *sdlParser) peek() gqlTok {
+	if p.eof() {
+		return gqlTok{}
+	}
+	return p.toks[p.i]
+}
+
+func (p *sdlParser) next() gqlTok {
+	t := p.peek()
+	p.i++
+	return t
+}
+
+func (p *sdlParser) isP(v string) bool {
+	t := p.peek()
+	return t.kind == 'p' && t.val == v
+}
+
+func (p *sdlParser) accept(v string) bool {
+	if p.isP(v) {
+		p.i++
+		return true
+	}
+	return false
+}
+
+func (p *sdlParser) skipDesc() {
+	for p.peek().kind == 's' {
+		p.i++
+	}
+}
+
+var gqlKeywords = map[string]bool{
+	"type": true, "interface": true, "input": true, "enum": true, "union": true, "scalar": true,
+	"schema": true, "extend": true, "directive": true, "query": true, "mutation": true,
+	"subscription": true, "fragment": true,
+}
+
+// parseSDL membaca definisi type system; mengembalikan true jika ada definisi yang ditemukan.
+func (c *gqlCollector) parseSDL(src, file string) bool {
+	p := &sdlParser{toks: lexGraphQL(src)}
+	found := false
+	for !p.eof() {
+		p.skipDesc()
+		t := p.next()
+		if t.kind == 'p' && t.val == "{" {
+			p.i--
+			p.skipBlock()
+			continue
+		}
+		if t.kind != 'n' {
+			continue
+		}
+		kw := t.val
+		if kw == "extend" {
+			kw = p.next().val
+		}
+		switch kw {
+		case "schema":
+			p.directives()
+			if p.accept("{") {
+				for !p.eof() && !p.accept("}") {
+					op := p.next().val
+					p.accept(":")
+					c.roots[op] = p.next().val
+				}
+			}
+			found = true
+		case "type", "interface", "input":
+			kind := kw
+			name := p.next().val
+			ty := c.typ(name, kind, file)
+			if p.peek().kind == 'n' && p.peek().val == "implements" {
+				p.i++
+				for !p.eof() {
+					if p.accept("&") {
+						continue
+					}
+					nt := p.peek()
+					if nt.kind != 'n' || gqlKeywords[nt.val] {
+						break
+					}
+					ty.Implements = append(ty.Implements, nt.val)
+					p.i++
+				}
+			}
+			p.directives()
+			if p.accept("{") {
+				for !p.eof() && !p.accept("}") {
+					p.skipDesc()
+					if p.isP("}") {
+						continue
+					}
+					f := p.field()
+					f.File = file
+					ty.Fields = append(ty.Fields, f)
+				}
+			}
+			found = true
+		case "enum":
+			ty := c.typ(p.next().val, "enum", file)
+			p.directives()
+			if p.accept("{") {
+				for !p.eof() && !p.accept("}") {
+					p.skipDesc()
+					if v := p.next(); v.kind == 'n' {
+						ty.Values = append(ty.Values, v.val)
+					}
+					p.directives()
+				}
+			}
+			found = true
+		case "union":
+			ty := c.typ(p.next().val, "union", file)
+			p.directives()
+			if p.accept("=") {
+				p.accept("|")
+				for !p.eof() {
+					nt := p.peek()
+					if nt.kind != 'n' || gqlKeywords[nt.val] {
+						break
+					}
+					ty.Values = append(ty.Values, nt.val)
+					p.i++
+					if !p.accept("|") {
+						break
+					}
+				}
+			}
+			found = true
+		case "scalar":
+			c.typ(p.next().val, "scalar", file)
+			p.directives()
+			found = true
+		case "directive":
+			p.accept("@")
+			p.next()
+			if p.isP("(") {
+				p.skipBlock()
+			}
+			if p.peek().val == "repeatable" {
+				p.i++
+			}
+			if p.peek().val == "on" {
+				p.i++
+				p.accept("|")
+				for !p.eof() && p.peek().kind == 'n' {
+					p.i++
+					if !p.accept("|") {
+						break
+					}
+				}
+			}
+		case "query", "mutation", "subscription", "fragment":
+			// executable document (client query), bukan schema
+			for !p.eof() && !p.isP("{") {
+				if p.isP("(") {
+					p.skipBlock()
+					continue
+				}
+				p.i++
+			}
+			p.skipBlock()
+		}
+	}
+	return found
+}
+
+// skipBlock melewati blok seimbang yang dimulai di token sekarang ({, ( atau [).
+func (p *sdlParser) skipBlock() {
+	depth := 0
+	for !p.eof() {
+		t := p.next()
+		if t.kind != 'p' {
+			continue
+		}
+		switch t.val {
+		case "{", "(", "[":
+			depth++
+		case "}", ")", "]":
+			depth--
+			if depth <= 0 {
+				return
+			}
+		}
+	}
+}
+
+func (p *sdlParser) field() GraphQLField {
+	f := GraphQLField{Name: p.next().val}
+	if p.accept("(") {
+		for !p.eof() && !p.accept(")") {
+			p.skipDesc()
+			if p.isP(")") {
+				continue
+			}
+			a := GraphQLArg{Name: p.next().val}
+			p.accept(":")
+			a.Type = p.typeRef()
+			if p.accept("=") {
+				a.Default = p.value()
+			}
+			p.directives()
+			f.Args = append(f.Args, a)
+		}
+	}
+	if p.accept(":") {
+		f.Type = p.typeRef()
+	}
+	f.Directives = p.directives()
+	return f
+}
+
+func (p *sdlParser) typeRef() string {
+	var s string
+	if p.accept("[") {
+		s = "[" + p.typeRef() + "]"
+		p.accept("]")
+	} else {
+		s = p.next().val
+	}
+	if p.accept("!") {
+		s += "!"
+	}
+	return s
+}
+
+func (p *sdlParser) value() string {
+	t := p.next()
+	switch {
+	case t.kind == 's':
+		return `"` + t.val + `"`
+	case t.kind == 'p' && t.val == "$":
+		return "$" + p.next().val
+	case t.kind == 'p' && t.val == "[":
+		var parts []string
+		for !p.eof() && !p.accept("]") {
+			parts = append(parts, p.value())
+		}
+		return "[" + strings.Join(parts, ", ") + "]"
+	case t.kind == 'p' && t.val == "{":
+		var parts []string
+		for !p.eof() && !p.accept("}") {
+			k := p.next().val
+			p.accept(":")
+			parts = append(parts, k+": "+p.value())
+		}
+		return "{" + strings.Join(parts, ", ") + "}"
+	}
+	return t.val
+}
+
+func (p *sdlParser) directives() []string {
+	var out []string
+	for p.accept("@") {
+		d := "@" + p.next().val
+		if p.accept("(") {
+			var args []string
+			for !p.eof() && !p.accept(")") {
+				k := p.next().val
+				p.accept(":")
+				args = append(args, k+": "+p.value())
+			}
+			d += "(" + strings.Join(args, ", ") + ")"
+		}
+		out = append(out, d)
+	}
+	return out
+}
+
+// ================= Apollo resolver maps =================
+
+// collectJSResolvers mencari objek resolver `Query: { users: ..., user(parent, args) {...} }`.
+func (c *gqlCollector) collectJSResolvers(text, file string) bool {
+	found := false
+	for _, loc := range reGqlResolver.FindAllStringSubmatchIndex(text, -1) {
+		typ := text[loc[2]:loc[3]]
+		open := loc[1] - 1
+		end := matchClose(text, open)
+		if end < 0 {
+			continue
+		}
+		for _, k := range topLevelKeys(text[open+1 : end]) {
+			c.addResolver(typ, k, file)
+			found = true
+		}
+	}
+	return found
+}
+
+// topLevelKeys mengambil nama key/method pada level teratas sebuah object literal JS.
+func topLevelKeys(body string) []string {
+	var keys []string
+	for _, part := range splitTopLevel(body, ',') {
+		part = strings.TrimSpace(part)
+		part = strings.TrimPrefix(part, "async ")
+		part = strings.TrimPrefix(part, "*")
+		part = strings.TrimSpace(part)
+		j := 0
+		for j < len(part) && (part[j] == '_' || part[j] == '$' || (part[j]|0x20 >= 'a' && part[j]|0x20 <= 'z') || (part[j] >= '0' && part[j] <= '9')) {
+			j++
+		}
+		if j == 0 {
+			continue
+		}
+		rest := strings.TrimSpace(part[j:])
+		if rest == "" || rest[0] == ':' || rest[0] == '(' {
+			keys = append(keys, part[:j])
+		}
+	}
+	return keys
+}
+
+// ================= graphene (Python) =================
+
+type grapheneClass struct {
+	name   string
+	bases  string
+	fields []GraphQLField
+	consts []string     // assignment biasa (nilai enum)
+	args   []GraphQLArg // class Arguments (graphene.Mutation)
+}
+
+func (c *gqlCollector) parseGraphene(text, file string) bool {
+	var classes []*grapheneClass
+	var cur *grapheneClass
+	inArgs := false
+	argsIndent := 0
+	for _, raw := range strings.Split(text, "\n") {
+		line := strings.TrimRight(raw, " \t\r")
+		trim := strings.TrimSpace(line)
+		if trim == "" || strings.HasPrefix(trim, "#") {
+			continue
+		}
+		indent := len(line) - len(strings.TrimLeft(line, " \t"))
+		if indent == 0 {
+			inArgs = false
+			cur = nil
+			if m := rePyClass.FindStringSubmatch(trim); m != nil {
+				cur = &grapheneClass{name: m[1], bases: m[2]}
+				classes = append(classes, cur)
+			}
+			continue
+		}
+		if cur == nil {
+			continue
+		}
+		if inArgs && indent <= argsIndent {
+			inArgs = false
+		}
+		if trim == "class Arguments:" || trim == "class Input:" {
+			inArgs = true
+			argsIndent = indent
+			continue
+		}
+		if m := rePyResolve.FindStringSubmatch(trim); m != nil {
+			c.addResolver(cur.name, m[1], file)
+			continue
+		}
+		if m := rePyAssign.FindStringSubmatch(trim); m != nil {
+			typ, args, ok := grapheneField(m[2])
+			if !ok {
+				if !inArgs {
+					cur.consts = append(cur.consts, m[1])
+				}
+				continue
+			}
+			if inArgs {
+				cur.args = append(cur.args, GraphQLArg{Name: snakeToCamel(m[1]), Type: typ})
+				continue
+			}
+			cur.fields = append(cur.fields, GraphQLField{Name: snakeToCamel(m[1]), Type: typ, Args: args, File: file})
+		}
+	}
+
+	mutArgs := map[string][]GraphQLArg{}
+	for _, cl := range classes {
+		if strings.Contains(cl.bases, "Mutation") && !strings.Contains(cl.bases, "ObjectType") {
+			mutArgs[cl.name] = cl.args
+		}
+	}
+	found := false
+	for _, cl := range classes {
+		kind := ""
+		switch {
+		case strings.Contains(cl.bases, "InputObjectType"):
+			kind = "input"
+		case strings.Contains(cl.bases, "ObjectType"):
+			kind = "type"
+		case strings.Contains(cl.bases, "Interface"):
+			kind = "interface"
+		case strings.Contains(cl.bases, "graphene.Enum"):
+			kind = "enum"
+		default:
+			continue
+		}
+		name := cl.name
+		if kind == "type" {
+			switch {
+			case strings.HasSuffix(name, "Query"):
+				name = c.roots["query"]
+			case strings.HasSuffix(name, "Mutation"):
+				name = c.roots["mutation"]
+			case strings.HasSuffix(name, "Subscription"):
+				name = c.roots["subscription"]
+			}
+		}
+		ty := c.typ(name, kind, file)
+		if kind == "enum" {
+			ty.Values = append(ty.Values, cl.consts...)
+		}
+		for _, f := range cl.fields {
+			if a, ok := mutArgs[f.Type]; ok && len(f.Args) == 0 {
+				f.Args = a
+			}
+			if kind == "enum" {
+				ty.Values = append(ty.Values, f.Name)
+				continue
+			}
+			if name != cl.name {
+				c.addResolver(name, f.Name, c.resolvers[cl.name+"."+normGqlName(f.Name)])
+			}
+			ty.Fields = append(ty.Fields, f)
+		}
+		found = true
+	}
+	for k, v := range c.resolvers {
+		if v == "" {
+			delete(c.resolvers, k)
+		}
+	}
+	return found
+}
+
+// grapheneField mengubah `graphene.List(UserType, id=graphene.Int())` menjadi tipe SDL + args.
+func grapheneField(expr string) (string, []GraphQLArg, bool) {
+	m := reGrapheneFn.FindStringSubmatch(strings.TrimSpace(expr))
+	if m == nil {
+		return "", nil, false
+	}
+	callee, inner := m[1], m[2]
+	parts := splitTopLevel(inner, ',')
+	required := false
+	var args []GraphQLArg
+	var positional []string
+	for _, p := range parts {
+		if i := strings.IndexByte(p, '='); i > 0 && !strings.ContainsAny(p[:i], "(\"'") {
+			k := strings.TrimSpace(p[:i])
+			v := strings.TrimSpace(p[i+1:])
+			switch k {
+			case "required":
+				required = v == "True"
+			case "description", "default_value", "resolver", "name", "deprecation_reason":
+			default:
+				if t, _, ok := grapheneField(v); ok {
+					args = append(args, GraphQLArg{Name: snakeToCamel(k), Type: t})
+				}
+			}
+			continue
+		}
+		positional = append(positional, p)
+	}
+	inner0 := ""
+	if len(positional) > 0 {
+		if t, _, ok := grapheneField(positional[0]); ok {
+			inner0 = t
+		} else {
+			inner0 = strings.TrimPrefix(strings.Trim(positional[0], `"'`), "graphene.")
+		}
+	}
+	var typ string
+	switch callee {
+	case "List":
+		typ = "[" + inner0 + "]"
+	case "NonNull":
+		typ = inner0 + "!"
+	case "Field", "Argument", "InputField", "ConnectionField":
+		typ = inner0
+	case "ID", "String", "Int", "Float", "Boolean", "Date", "DateTime", "Time", "Decimal", "JSONString", "UUID", "GenericScalar":
+		typ = callee
+	default:
+		// CreateUser.Field() / custom scalar
+		if strings.HasSuffix(strings.TrimSpace(expr), ".Field()") {
+			typ = callee
+		} else if callee != "" && callee[0] >= 'A' && callee[0] <= 'Z' {
+			typ = callee
+		} else {
+			return "", nil, false
+		}
+	}
+	if required {
+		typ += "!"
+	}
+	return typ, args, true
+}
+
+func snakeToCamel(s string) string {
+	parts := strings.Split(s, "_")
+	for i := 1; i < len(parts); i++ {
+		parts[i] = upperFirst(parts[i])
+	}
+	return strings.Join(parts, "")
+}
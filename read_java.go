@@ -1,47 +1,408 @@
 package main
 
 import (
+	"cmp"
+	"encoding/xml"
+	"maps"
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 )
 
 func readJava(root string) *JavaInfo {
-	pom := filepath.Join(root, "pom.xml")
-	if exists(pom) {
-		b, _ := os.ReadFile(pom)
-		ji := &JavaInfo{BuildTool: "maven", Deps: map[string]string{}}
-		reGA := regexp.MustCompile(`(?s)<groupId>([^<]+)</groupId>.*?<artifactId>([^<]+)</artifactId>`)
-		if m := reGA.FindSubmatch(b); len(m) >= 3 {
-			ji.GroupID = strings.TrimSpace(string(m[1]))
-			ji.Artifact = strings.TrimSpace(string(m[2]))
-		}
-		reDep := regexp.MustCompile(`(?s)<dependency>.*?<groupId>([^<]+)</groupId>.*?<artifactId>([^<]+)</artifactId>.*?(?:<version>([^<]+)</version>)?.*?</dependency>`)
-		for _, mm := range reDep.FindAllSubmatch(b, -1) {
-			key := strings.TrimSpace(string(mm[1])) + ":" + strings.TrimSpace(string(mm[2]))
-			val := ""
-			if len(mm) >= 4 {
-				val = strings.TrimSpace(string(mm[3]))
+	if exists(filepath.Join(root, "pom.xml")) {
+		return readMaven(root)
+	}
+	if gradle := firstExist(root, []string{"build.gradle.kts", "build.gradle"}); gradle != "" {
+		return readGradle(root, gradle)
+	}
+	return nil
+}
+
+// ================= Maven =================
+
+type pomXML struct {
+	GroupID    string `xml:"groupId"`
+	ArtifactID string `xml:"artifactId"`
+	Version    string `xml:"version"`
+	Packaging  string `xml:"packaging"`
+	Parent     *struct {
+		GroupID      string  `xml:"groupId"`
+		ArtifactID   string  `xml:"artifactId"`
+		Version      string  `xml:"version"`
+		RelativePath *string `xml:"relativePath"`
+	} `xml:"parent"`
+	Properties   pomProps `xml:"properties"`
+	Modules      []string `xml:"modules>module"`
+	Dependencies []pomDep `xml:"dependencies>dependency"`
+	Managed      []pomDep `xml:"dependencyManagement>dependencies>dependency"`
+	Plugins      []pomDep `xml:"build>plugins>plugin"`
+}
+
+type pomDep struct {
+	GroupID    string `xml:"groupId"`
+	ArtifactID string `xml:"artifactId"`
+	Version    string `xml:"version"`
+	Scope      string `xml:"scope"`
+	Optional   string `xml:"optional"`
+}
+
+// pomProps menampung <properties> dengan nama elemen bebas.
+type pomProps map[string]string
+
+func (p *pomProps) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*p = pomProps{}
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			var v string
+			if err := d.DecodeElement(&v, &t); err != nil {
+				return err
 			}
-			ji.Deps[key] = val
+			(*p)[t.Name.Local] = strings.TrimSpace(v)
+		case xml.EndElement:
+			return nil
 		}
-		return ji
 	}
-	gradle := firstExist(root, []string{"build.gradle.kts", "build.gradle"})
-	if gradle != "" {
-		b, _ := os.ReadFile(filepath.Join(root, gradle))
-		ji := &JavaInfo{BuildTool: "gradle", Deps: map[string]string{}}
-		rePlugin := regexp.MustCompile(`id\("([^"]+)"\)`)
-		for _, mm := range rePlugin.FindAllSubmatch(b, -1) {
-			ji.Plugins = append(ji.Plugins, string(mm[1]))
+}
+
+func readPOM(full string) *pomXML {
+	b, err := os.ReadFile(full)
+	if err != nil {
+		return nil
+	}
+	var p pomXML
+	if xml.Unmarshal(b, &p) != nil {
+		return nil
+	}
+	return &p
+}
+
+// pomParents memuat rantai parent lokal (relativePath, default ../pom.xml) dari yang terdekat.
+func pomParents(dir string, p *pomXML) []*pomXML {
+	var out []*pomXML
+	for depth := 0; p.Parent != nil && depth < 10; depth++ {
+		rel := "../pom.xml"
+		if p.Parent.RelativePath != nil {
+			rel = strings.TrimSpace(*p.Parent.RelativePath)
+		}
+		if rel == "" {
+			break
 		}
-		reDep := regexp.MustCompile(`["']([^:"']+):([^:"']+):([^"']+)["']`)
-		for _, mm := range reDep.FindAllSubmatch(b, -1) {
-			key := string(mm[1]) + ":" + string(mm[2])
-			ji.Deps[key] = string(mm[3])
+		full := filepath.Join(dir, filepath.FromSlash(rel))
+		if !strings.HasSuffix(full, ".xml") {
+			full = filepath.Join(full, "pom.xml")
 		}
-		return ji
+		parent := readPOM(full)
+		if parent == nil || parent.ArtifactID != p.Parent.ArtifactID {
+			break
+		}
+		out = append(out, parent)
+		dir, p = filepath.Dir(full), parent
 	}
-	return nil
+	return out
+}
+
+var rePomProp = regexp.MustCompile(`\$\{([^}]+)\}`)
+
+// resolveProps mengganti ${x} secara berulang (property bersarang); yang tidak dikenal dibiarkan.
+func resolveProps(s string, props map[string]string) string {
+	for i := 0; i < 10 && strings.Contains(s, "${"); i++ {
+		next := rePomProp.ReplaceAllStringFunc(s, func(m string) string {
+			if v, ok := props[m[2:len(m)-1]]; ok {
+				return v
+			}
+			return m
+		})
+		if next == s {
+			break
+		}
+		s = next
+	}
+	return s
+}
+
+func readMaven(root string) *JavaInfo {
+	p := readPOM(filepath.Join(root, "pom.xml"))
+	if p == nil {
+		return &JavaInfo{BuildTool: "maven"}
+	}
+	parents := pomParents(root, p)
+
+	ji := &JavaInfo{BuildTool: "maven", GroupID: p.GroupID, Artifact: p.ArtifactID, Version: p.Version, Packaging: p.Packaging, Modules: p.Modules}
+	if p.Parent != nil {
+		ji.Parent = &JavaCoord{GroupID: p.Parent.GroupID, Artifact: p.Parent.ArtifactID, Version: p.Parent.Version}
+		// groupId/version diwarisi dari parent bila tidak ditulis
+		ji.GroupID = cmp.Or(ji.GroupID, p.Parent.GroupID)
+		ji.Version = cmp.Or(ji.Version, p.Parent.Version)
+	}
+
+	// property efektif: parent terjauh dulu, lalu di-override anak
+	props := map[string]string{}
+	for _, pp := range slices.Backward(parents) {
+		maps.Copy(props, pp.Properties)
+	}
+	maps.Copy(props, p.Properties)
+	ji.Properties = maps.Clone(props)
+	builtin := map[string]string{
+		"project.groupId": ji.GroupID, "project.artifactId": ji.Artifact, "project.version": ji.Version,
+		"project.packaging": cmp.Or(ji.Packaging, "jar"),
+	}
+	if ji.Parent != nil {
+		builtin["project.parent.groupId"] = ji.Parent.GroupID
+		builtin["project.parent.artifactId"] = ji.Parent.Artifact
+		builtin["project.parent.version"] = ji.Parent.Version
+	}
+	for k, v := range builtin {
+		props[k] = v
+		props["pom."+strings.TrimPrefix(k, "project.")] = v // alias lama
+	}
+	ji.Version = resolveProps(ji.Version, props)
+	props["project.version"] = ji.Version
+	for k, v := range ji.Properties {
+		ji.Properties[k] = resolveProps(v, props)
+	}
+	if len(ji.Properties) == 0 {
+		ji.Properties = nil
+	}
+
+	toDep := func(d pomDep) JavaDep {
+		return JavaDep{
+			Group:    resolveProps(strings.TrimSpace(d.GroupID), props),
+			Artifact: resolveProps(strings.TrimSpace(d.ArtifactID), props),
+			Version:  resolveProps(strings.TrimSpace(d.Version), props),
+			Scope:    strings.TrimSpace(d.Scope),
+			Optional: strings.TrimSpace(d.Optional) == "true",
+		}
+	}
+	// dependencyManagement: milik sendiri menang atas parent
+	managed := map[string]JavaDep{}
+	for _, pp := range slices.Backward(parents) {
+		for _, d := range pp.Managed {
+			jd := toDep(d)
+			managed[jd.Group+":"+jd.Artifact] = jd
+		}
+	}
+	for _, d := range p.Managed {
+		jd := toDep(d)
+		managed[jd.Group+":"+jd.Artifact] = jd
+		ji.Managed = append(ji.Managed, jd)
+	}
+
+	ji.Deps = map[string]string{}
+	for _, d := range p.Dependencies {
+		jd := toDep(d)
+		key := jd.Group + ":" + jd.Artifact
+		if md, ok := managed[key]; ok {
+			jd.Version = cmp.Or(jd.Version, md.Version)
+			jd.Scope = cmp.Or(jd.Scope, md.Scope)
+		}
+		jd.Scope = cmp.Or(jd.Scope, "compile")
+		ji.Dependencies = append(ji.Dependencies, jd)
+		ji.Deps[key] = jd.Version
+	}
+	for _, pl := range p.Plugins {
+		jd := toDep(pl)
+		ji.Plugins = append(ji.Plugins, cmp.Or(jd.Group, "org.apache.maven.plugins")+":"+jd.Artifact)
+	}
+	return ji
+}
+
+// ================= Gradle =================
+
+var (
+	reGradlePluginID  = regexp.MustCompile(`\bid\s*\(?\s*["']([^"']+)["']`)
+	reGradleKotlinPl  = regexp.MustCompile(`\bkotlin\(\s*"([^"]+)"\s*\)`)
+	reGradlePluginRef = regexp.MustCompile(`\balias\(\s*libs\.plugins\.([\w.]+)\s*\)`)
+	reGradleCoord     = regexp.MustCompile(`["']([^:"'\s]+):([^:"'\s]+):([^"'\s]+)["']`)
+	reGradleLibRef    = regexp.MustCompile(`\blibs\.([\w.]+)`)
+	reGradleConfig    = regexp.MustCompile(`^\s*([a-z][A-Za-z]*)\s*[\s(]`)
+	reGradleGroup     = regexp.MustCompile(`(?m)^\s*group\s*=\s*["']([^"']+)["']`)
+	reGradleVersion   = regexp.MustCompile(`(?m)^\s*version\s*=\s*["']([^"']+)["']`)
+)
+
+func readGradle(root, file string) *JavaInfo {
+	b, _ := os.ReadFile(filepath.Join(root, file))
+	text := string(b)
+	ji := &JavaInfo{BuildTool: "gradle", Deps: map[string]string{}, Catalog: readGradleCatalog(root)}
+	if m := reGradleGroup.FindStringSubmatch(text); m != nil {
+		ji.GroupID = m[1]
+	}
+	if m := reGradleVersion.FindStringSubmatch(text); m != nil {
+		ji.Version = m[1]
+	}
+	cat := ji.Catalog
+	if cat == nil {
+		cat = &GradleCatalog{}
+	}
+	// accessor libs.foo.bar cocok dengan alias foo-bar / foo_bar / foo.bar
+	byAccessor := func(tbl map[string]string) map[string]string {
+		out := map[string]string{}
+		for alias := range tbl {
+			out[strings.NewReplacer("-", ".", "_", ".").Replace(alias)] = alias
+		}
+		return out
+	}
+	libAlias, pluginAlias, bundleAlias := byAccessor(cat.Libraries), byAccessor(cat.Plugins), map[string]string{}
+	for alias := range cat.Bundles {
+		bundleAlias[strings.NewReplacer("-", ".", "_", ".").Replace(alias)] = alias
+	}
+
+	for _, mm := range reGradlePluginID.FindAllStringSubmatch(text, -1) {
+		ji.Plugins = append(ji.Plugins, mm[1])
+	}
+	for _, mm := range reGradleKotlinPl.FindAllStringSubmatch(text, -1) {
+		ji.Plugins = append(ji.Plugins, "org.jetbrains.kotlin."+mm[1])
+	}
+	for _, mm := range reGradlePluginRef.FindAllStringSubmatch(text, -1) {
+		if alias, ok := pluginAlias[mm[1]]; ok {
+			id, _, _ := strings.Cut(cat.Plugins[alias], ":")
+			ji.Plugins = append(ji.Plugins, id)
+		}
+	}
+	ji.Plugins = unique(ji.Plugins)
+
+	add := func(coord, scope, alias string) {
+		parts := strings.SplitN(coord, ":", 3)
+		if len(parts) < 2 {
+			return
+		}
+		d := JavaDep{Group: parts[0], Artifact: parts[1], Scope: scope, Alias: alias}
+		if len(parts) == 3 {
+			d.Version = parts[2]
+		}
+		if strings.Contains(scope, "platform") || strings.HasPrefix(scope, "enforcedPlatform") {
+			ji.Managed = append(ji.Managed, d)
+			return
+		}
+		ji.Dependencies = append(ji.Dependencies, d)
+		ji.Deps[d.Group+":"+d.Artifact] = d.Version
+	}
+	for _, line := range strings.Split(text, "\n") {
+		if strings.Contains(line, "alias(libs.plugins.") {
+			continue
+		}
+		scope := ""
+		if m := reGradleConfig.FindStringSubmatch(line); m != nil && m[1] != "id" && m[1] != "version" && m[1] != "group" {
+			scope = m[1]
+			// implementation(platform(...)) -> BOM
+			if strings.Contains(line, "platform(") {
+				scope += "/platform"
+			}
+		}
+		for _, mm := range reGradleCoord.FindAllStringSubmatch(line, -1) {
+			add(mm[1]+":"+mm[2]+":"+mm[3], scope, "")
+		}
+		for _, mm := range reGradleLibRef.FindAllStringSubmatch(line, -1) {
+			ref := mm[1]
+			switch {
+			case strings.HasPrefix(ref, "bundles."):
+				for _, lib := range cat.Bundles[bundleAlias[strings.TrimPrefix(ref, "bundles.")]] {
+					add(cat.Libraries[lib], scope, lib)
+				}
+			case strings.HasPrefix(ref, "versions."), strings.HasPrefix(ref, "plugins."):
+			default:
+				if alias, ok := libAlias[ref]; ok {
+					add(cat.Libraries[alias], scope, alias)
+				}
+			}
+		}
+	}
+	return ji
+}
+
+// readGradleCatalog mencari gradle/libs.versions.toml di root build (naik sampai settings.gradle).
+func readGradleCatalog(dir string) *GradleCatalog {
+	full := ""
+	for i := 0; i < 6; i++ {
+		if p := filepath.Join(dir, "gradle", "libs.versions.toml"); exists(p) {
+			full = p
+			break
+		}
+		if firstExist(dir, []string{"settings.gradle.kts", "settings.gradle"}) != "" {
+			break
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			break
+		}
+		dir = parent
+	}
+	if full == "" {
+		return nil
+	}
+	t := readTOML(full)
+	cat := &GradleCatalog{Versions: map[string]string{}}
+	versions, _ := t["versions"].(map[string]any)
+	for k, v := range versions {
+		cat.Versions[k] = catalogVersion(v, nil)
+	}
+	if libs, ok := t["libraries"].(map[string]any); ok {
+		cat.Libraries = map[string]string{}
+		for alias, v := range libs {
+			coord := ""
+			switch v := v.(type) {
+			case string:
+				coord = v
+			case map[string]any:
+				coord = toStr(v["module"])
+				if coord == "" {
+					coord = toStr(v["group"]) + ":" + toStr(v["name"])
+				}
+				if ver := catalogVersion(v["version"], cat.Versions); ver != "" {
+					coord += ":" + ver
+				}
+			}
+			cat.Libraries[alias] = coord
+		}
+	}
+	if bundles, ok := t["bundles"].(map[string]any); ok {
+		cat.Bundles = map[string][]string{}
+		for alias, v := range bundles {
+			cat.Bundles[alias] = toStrings(v)
+		}
+	}
+	if plugins, ok := t["plugins"].(map[string]any); ok {
+		cat.Plugins = map[string]string{}
+		for alias, v := range plugins {
+			switch v := v.(type) {
+			case string:
+				cat.Plugins[alias] = v
+			case map[string]any:
+				id := toStr(v["id"])
+				if ver := catalogVersion(v["version"], cat.Versions); ver != "" {
+					id += ":" + ver
+				}
+				cat.Plugins[alias] = id
+			}
+		}
+	}
+	if len(cat.Versions) == 0 {
+		cat.Versions = nil
+	}
+	return cat
+}
+
+// catalogVersion: "1.0", { ref = "x" } (version.ref), atau rich version { strictly/require/prefer }.
+func catalogVersion(v any, versions map[string]string) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case map[string]any:
+		if ref := toStr(v["ref"]); ref != "" {
+			return versions[ref]
+		}
+		for _, k := range []string{"strictly", "require", "prefer"} {
+			if s := toStr(v[k]); s != "" {
+				return s
+			}
+		}
+	}
+	return ""
 }
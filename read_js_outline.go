@@ -0,0 +1,425 @@
+package main
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var jsSourceExts = []string{".ts", ".tsx", ".mts", ".cts", ".js", ".jsx", ".mjs", ".cjs"}
+
+// readJSOutline membuat outline module TS/JS (tanpa type checker) plus alias tsconfig.
+func readJSOutline(root string) *JSOutline {
+	out := &JSOutline{}
+	for _, name := range []string{"tsconfig.json", "jsconfig.json"} {
+		if base, paths, ok := readTSConfigPaths(filepath.Join(root, name), 0); ok {
+			out.TSConfig, out.BaseURL, out.Paths = name, base, paths
+			break
+		}
+	}
+	nest := &NestInfo{}
+	walkFiles(root, func(full, rel string) {
+		lower := strings.ToLower(rel)
+		if !slices.Contains(jsSourceExts, path.Ext(lower)) || strings.HasSuffix(lower, ".d.ts") || strings.Contains(lower, ".min.") ||
+			strings.Contains(lower, ".test.") || strings.Contains(lower, ".spec.") || strings.Contains(lower, "__tests__/") {
+			return
+		}
+		b, err := os.ReadFile(full)
+		if err != nil || len(b) > 512<<10 {
+			return
+		}
+		if mod, ok := parseJSModule(string(b), rel, nest); ok {
+			out.Modules = append(out.Modules, mod)
+		}
+	})
+	if len(nest.Modules)+len(nest.Controllers)+len(nest.Providers) > 0 {
+		out.Nest = nest
+	}
+	if len(out.Modules) == 0 && out.Paths == nil {
+		return nil
+	}
+	slices.SortFunc(out.Modules, func(a, b JSModule) int { return strings.Compare(a.Path, b.Path) })
+	return out
+}
+
+// readTSConfigPaths membaca compilerOptions.baseUrl/paths, mengikuti "extends" relatif.
+func readTSConfigPaths(full string, depth int) (string, map[string][]string, bool) {
+	b, err := os.ReadFile(full)
+	if err != nil || depth > 4 {
+		return "", nil, false
+	}
+	cfg, ok := jsValue(jsStripComments(string(b))).(jsObject)
+	if !ok {
+		return "", nil, false
+	}
+	var baseURL string
+	var paths map[string][]string
+	if ext := cfg.str("extends"); strings.HasPrefix(ext, ".") {
+		if !strings.HasSuffix(ext, ".json") {
+			ext += ".json"
+		}
+		parentBase, parentPaths, _ := readTSConfigPaths(filepath.Join(filepath.Dir(full), ext), depth+1)
+		if parentBase != "" {
+			// baseUrl relatif terhadap file yang mendefinisikannya
+			baseURL = path.Clean(path.Join(path.Dir(filepath.ToSlash(ext)), parentBase))
+		}
+		paths = parentPaths
+	}
+	if co, ok := cfg.get("compilerOptions"); ok {
+		if co, ok := co.(jsObject); ok {
+			if v := co.str("baseUrl"); v != "" {
+				baseURL = v
+			}
+			if p, ok := co.get("paths"); ok {
+				if p, ok := p.(jsObject); ok {
+					paths = map[string][]string{}
+					for _, e := range p {
+						if e.Key == "" {
+							continue
+						}
+						arr, _ := e.Val.(jsArray)
+						for _, t := range arr {
+							paths[e.Key] = append(paths[e.Key], jsString(t))
+						}
+					}
+				}
+			}
+		}
+	}
+	return baseURL, paths, true
+}
+
+var (
+	reJSImport      = regexp.MustCompile(`(?m)(?:^|[;\s])(?:import|export)\s+(?:type\s+)?(?:[\w*{}\s,$]+?\s+from\s+)?['"]([^'"]+)['"]|\brequire\(\s*['"]([^'"]+)['"]\s*\)|\bimport\(\s*['"]([^'"]+)['"]\s*\)`)
+	reJSFunc        = regexp.MustCompile(`^(export\s+)?(default\s+)?(?:declare\s+)?(?:async\s+)?function\s*\*?\s*(\w*)\s*(<[^>(]*>)?\s*\(`)
+	reJSClass       = regexp.MustCompile(`^(export\s+)?(default\s+)?(?:declare\s+)?(?:abstract\s+)?class\s+(\w*)\s*(?:<[^>{]*>)?\s*(?:extends\s+([\w.]+(?:<[^>{]*>)?))?`)
+	reJSVar         = regexp.MustCompile(`^(export\s+)?(?:declare\s+)?(const|let|var)\s+(\w+)\s*(?::\s*([^=]+?))?\s*=\s*`)
+	reJSTypeDecl    = regexp.MustCompile(`^(export\s+)?(?:declare\s+)?(interface|type|(?:const\s+)?enum)\s+(\w+)`)
+	reJSExportList  = regexp.MustCompile(`^export\s+(?:type\s+)?\{([^}]*)\}\s*(?:from\s*['"]([^'"]+)['"])?`)
+	reJSExportStar  = regexp.MustCompile(`^export\s+\*\s*(?:as\s+(\w+)\s+)?from\s*['"]([^'"]+)['"]`)
+	reJSExportDef   = regexp.MustCompile(`^export\s+default\s+`)
+	reJSModExports  = regexp.MustCompile(`^(?:module\.)?exports(?:\.(\w+))?\s*=\s*`)
+	reJSArrow       = regexp.MustCompile(`^(?:async\s+)?(?:<[^>]*>\s*)?(\([^)]*\)|\w+)\s*(?::\s*([^=]+?))?\s*=>`)
+	reJSFuncExpr    = regexp.MustCompile(`^(?:async\s+)?function\b`)
+	reJSComponentFn = regexp.MustCompile(`^(?:React\.)?(?:memo|forwardRef)\s*(?:<[^>]*>)?\(`)
+	reJSReactClass  = regexp.MustCompile(`^(?:React\.)?(?:Pure)?Component\b`)
+	reJSHook        = regexp.MustCompile(`^use[A-Z0-9]`)
+	reJSPascal      = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+)
+
+// parseJSModule membaca statement top-level satu file TS/JS.
+func parseJSModule(text, rel string, nest *NestInfo) (JSModule, bool) {
+	mod := JSModule{Path: rel}
+	src := jsStripComments(text)
+	ext := path.Ext(strings.ToLower(rel))
+	jsx := ext == ".tsx" || ext == ".jsx" || strings.Contains(src, "from 'react'") || strings.Contains(src, `from "react"`)
+	for _, m := range reJSImport.FindAllStringSubmatch(src, -1) {
+		mod.Imports = append(mod.Imports, m[1]+m[2]+m[3])
+	}
+	mod.Imports = unique(mod.Imports)
+
+	var decos []tsDecorator
+	skip := -1
+	for _, off := range jsTopLevel(src) {
+		if off <= skip {
+			continue
+		}
+		pos := off
+		if src[off] == '@' {
+			// decorator (bisa beberapa baris) langsung diikuti class yang didekorasi
+			ms := tsMembers(src[off:], off)
+			if len(ms) == 0 {
+				continue
+			}
+			decos = ms[0].Decorators
+			pos, skip = ms[0].Offset, ms[0].Offset
+		}
+		st := src[pos:]
+		line := lineAt(src, pos)
+		isComponent := func(name string) bool { return jsx && reJSPascal.MatchString(name) }
+		switch {
+		case reJSExportStar.MatchString(st):
+			m := reJSExportStar.FindStringSubmatch(st)
+			mod.ReExports = append(mod.ReExports, m[2])
+			if m[1] != "" {
+				mod.Exports = append(mod.Exports, JSExport{Name: m[1], Kind: "namespace", Line: line})
+			}
+		case reJSExportList.MatchString(st):
+			m := reJSExportList.FindStringSubmatch(st)
+			if m[2] != "" {
+				mod.ReExports = append(mod.ReExports, m[2])
+			}
+			for _, n := range strings.Split(m[1], ",") {
+				f := strings.Fields(strings.TrimPrefix(strings.TrimSpace(n), "type "))
+				if len(f) == 0 {
+					continue
+				}
+				name := f[len(f)-1] // `a as b` -> b
+				if name == "default" {
+					mod.Default, mod.DefaultLine = f[0], line
+					continue
+				}
+				mod.Exports = append(mod.Exports, JSExport{Name: name, Kind: "ref", Line: line})
+			}
+		case reJSClass.MatchString(st):
+			m := reJSClass.FindStringSubmatch(st)
+			e := JSExport{Name: m[3], Kind: "class", Line: line, Extends: m[4]}
+			for _, d := range decos {
+				e.Decorators = append(e.Decorators, d.Name)
+			}
+			nestClass(nest, e, decos, rel)
+			if reJSReactClass.MatchString(e.Extends) && e.Name != "" {
+				mod.Components = append(mod.Components, e.Name)
+			}
+			jsExport(&mod, e, m[1] != "", m[2] != "")
+		case reJSFunc.MatchString(st):
+			m := reJSFunc.FindStringSubmatch(st)
+			e := JSExport{Name: m[3], Kind: "function", Line: line, Signature: jsSignature(st[len(m[0])-1:])}
+			if isComponent(e.Name) {
+				mod.Components = append(mod.Components, e.Name)
+			} else if reJSHook.MatchString(e.Name) {
+				mod.Hooks = append(mod.Hooks, e.Name)
+			}
+			jsExport(&mod, e, m[1] != "", m[2] != "")
+		case reJSVar.MatchString(st):
+			m := reJSVar.FindStringSubmatch(st)
+			rhs := st[len(m[0]):]
+			e := JSExport{Name: m[3], Kind: "const", Line: line}
+			if m[2] != "const" {
+				e.Kind = m[2]
+			}
+			switch {
+			case reJSArrow.MatchString(rhs):
+				e.Kind = "function"
+				if r := strings.TrimPrefix(rhs, "async "); strings.HasPrefix(r, "(") {
+					e.Signature = jsSignature(r)
+				}
+			case reJSFuncExpr.MatchString(rhs):
+				e.Kind = "function"
+				if i := strings.IndexByte(rhs, '('); i >= 0 {
+					e.Signature = jsSignature(rhs[i:])
+				}
+			}
+			if m[4] != "" && e.Signature == "" {
+				e.Signature = strings.TrimSpace(m[4]) // const X: React.FC<Props> = ...
+			}
+			switch {
+			case isComponent(e.Name) && (e.Kind == "function" || reJSComponentFn.MatchString(rhs) || strings.Contains(m[4], "FC")):
+				mod.Components = append(mod.Components, e.Name)
+			case reJSHook.MatchString(e.Name) && e.Kind == "function":
+				mod.Hooks = append(mod.Hooks, e.Name)
+			}
+			jsExport(&mod, e, m[1] != "", false)
+		case reJSTypeDecl.MatchString(st):
+			m := reJSTypeDecl.FindStringSubmatch(st)
+			kind := m[2]
+			if strings.HasSuffix(kind, "enum") {
+				kind = "enum"
+			}
+			jsExport(&mod, JSExport{Name: m[3], Kind: kind, Line: line}, m[1] != "", false)
+		case reJSExportDef.MatchString(st):
+			expr := strings.TrimSpace(st[len(reJSExportDef.FindString(st)):])
+			mod.Default, mod.DefaultLine = jsDefaultName(expr), line
+		case reJSModExports.MatchString(st):
+			m := reJSModExports.FindStringSubmatch(st)
+			expr := strings.TrimSpace(st[len(m[0]):])
+			if m[1] != "" {
+				mod.Exports = append(mod.Exports, JSExport{Name: m[1], Kind: "cjs", Line: line})
+			} else if obj, ok := jsValue(jsStatement(expr)).(jsObject); ok {
+				for _, p := range obj {
+					mod.Exports = append(mod.Exports, JSExport{Name: p.Key, Kind: "cjs", Line: line})
+				}
+			} else {
+				mod.Default, mod.DefaultLine = jsDefaultName(expr), line
+			}
+		}
+		decos = nil
+	}
+	if len(mod.Exports) == 0 && mod.Default == "" && len(mod.ReExports) == 0 && len(mod.Components) == 0 && len(mod.Hooks) == 0 {
+		return mod, len(mod.Imports) > 0
+	}
+	return mod, true
+}
+
+// jsExport mencatat deklarasi bila di-export.
+func jsExport(mod *JSModule, e JSExport, exported, isDefault bool) {
+	switch {
+	case isDefault:
+		mod.Default, mod.DefaultLine = e.Name, e.Line
+		if e.Name == "" {
+			mod.Default = "(anonymous " + e.Kind + ")"
+		}
+	case exported:
+		mod.Exports = append(mod.Exports, e)
+	}
+}
+
+// jsDefaultName meringkas ekspresi `export default ...`.
+func jsDefaultName(expr string) string {
+	expr = jsStatement(expr)
+	if rePyCallee.MatchString(expr) {
+		return expr
+	}
+	return truncate(strings.Join(strings.Fields(expr), " "), 80)
+}
+
+// jsStatement memotong src sampai akhir statement pertama (";" atau newline di depth 0).
+func jsStatement(src string) string {
+	depth := 0
+	for i := 0; i < len(src); i++ {
+		switch c := src[i]; c {
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			depth--
+		case '"', '\'', '`':
+			for i++; i < len(src) && src[i] != c; i++ {
+				if src[i] == '\\' {
+					i++
+				}
+			}
+		case ';', '\n':
+			if depth <= 0 {
+				return strings.TrimSpace(src[:i])
+			}
+		}
+	}
+	return strings.TrimSpace(src)
+}
+
+// jsSignature mengambil `(params): Ret` mulai dari kurung buka parameter.
+func jsSignature(s string) string {
+	end := matchClose(s, 0)
+	if end < 0 {
+		return ""
+	}
+	sig := s[:end+1]
+	rest := strings.TrimSpace(s[end+1:])
+	if strings.HasPrefix(rest, ":") {
+		// return type sampai `{` atau `=>` di depth 0
+		depth := 0
+		for i := 1; i < len(rest); i++ {
+			switch rest[i] {
+			case '<', '(', '[':
+				depth++
+			case '>', ')', ']':
+				if rest[i] == '>' && rest[i-1] == '=' {
+					if depth == 0 {
+						sig += rest[:i-1]
+						rest = ""
+					}
+					continue
+				}
+				depth--
+			case '{':
+				if depth == 0 && i > 1 {
+					sig += rest[:i]
+					rest = ""
+				} else {
+					depth++
+				}
+			case '}':
+				depth--
+			}
+			if rest == "" {
+				break
+			}
+		}
+	}
+	return truncate(strings.Join(strings.Fields(sig), " "), 200)
+}
+
+// jsTopLevel mengembalikan offset awal baris yang berada di depth 0 (di luar kurung/string).
+func jsTopLevel(src string) []int {
+	var out []int
+	depth := 0
+	lineStart := true
+	for i := 0; i < len(src); i++ {
+		c := src[i]
+		if lineStart && c != ' ' && c != '\t' && c != '\r' && c != '\n' {
+			lineStart = false
+			if depth == 0 && c != '}' && c != ')' && c != ']' && c != '.' {
+				out = append(out, i)
+			}
+		}
+		switch c {
+		case '\n':
+			lineStart = true
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			depth = max(depth-1, 0)
+		case '"', '\'', '`':
+			for i++; i < len(src) && src[i] != c; i++ {
+				if src[i] == '\\' {
+					i++
+				} else if src[i] == '\n' && c != '`' {
+					break
+				}
+			}
+		}
+	}
+	return out
+}
+
+// nestClass mendaftarkan class ber-decorator NestJS.
+func nestClass(nest *NestInfo, e JSExport, decos []tsDecorator, rel string) {
+	global := false
+	for _, d := range decos {
+		if d.Name == "Global" {
+			global = true
+		}
+	}
+	for _, d := range decos {
+		arg := ""
+		if len(d.Args) > 0 {
+			arg = strings.TrimSpace(d.Args[0])
+		}
+		switch d.Name {
+		case "Module":
+			nm := NestModule{Name: e.Name, File: rel, Line: e.Line, Global: global}
+			if o, ok := jsValue(arg).(jsObject); ok {
+				nm.Imports = nestRefs(o, "imports")
+				nm.Controllers = nestRefs(o, "controllers")
+				nm.Providers = nestRefs(o, "providers")
+				nm.Exports = nestRefs(o, "exports")
+			}
+			nest.Modules = append(nest.Modules, nm)
+		case "Controller":
+			c := NestClass{Name: e.Name, File: rel, Line: e.Line}
+			switch v := jsValue(arg).(type) {
+			case string:
+				c.Path = v
+			case jsObject:
+				c.Path = v.str("path")
+			}
+			nest.Controllers = append(nest.Controllers, c)
+		case "Injectable", "Resolver", "WebSocketGateway", "Processor", "Catch":
+			nest.Providers = append(nest.Providers, NestClass{Name: e.Name, Kind: lowerFirst(d.Name), File: rel, Line: e.Line})
+		}
+	}
+}
+
+// nestRefs membaca array class di metadata @Module; provider object memakai `provide`,
+// pemanggilan seperti TypeOrmModule.forRoot(...) diringkas ke nama callee.
+func nestRefs(o jsObject, key string) []string {
+	v, _ := o.get(key)
+	arr, _ := v.(jsArray)
+	var out []string
+	for _, e := range arr {
+		switch x := e.(type) {
+		case jsObject:
+			out = append(out, strings.Trim(x.str("provide"), "'\"`"))
+		default:
+			s := jsString(x)
+			if i := strings.IndexByte(s, '('); i > 0 {
+				s = s[:i]
+			}
+			out = append(out, strings.TrimPrefix(s, "..."))
+		}
+	}
+	return out
+}
@@ -0,0 +1,596 @@
+package main
+
+import (
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// ================= JVM (Java/Kotlin) outline =================
+
+var (
+	reJVMPackage  = regexp.MustCompile(`(?m)^\s*package\s+([\w.]+)`)
+	reJVMAnno     = regexp.MustCompile(`^@([\w.]+)`)
+	reJVMTypeDecl = regexp.MustCompile(`^((?:(?:public|private|protected|internal|abstract|final|static|sealed|non-sealed|open|data|enum|annotation|inner|value|inline|strictfp|expect|actual)\s+)*)(class|interface|enum|record|@interface|object)\s+(\w+)`)
+	reJavaMethod  = regexp.MustCompile(`^((?:(?:public|private|protected|abstract|final|static|synchronized|native|default|strictfp)\s+)*)(?:<[^>]*>\s*)?([\w.<>\[\]?,\s]+?)\s+(\w+)\s*\(`)
+	reJavaField   = regexp.MustCompile(`^((?:(?:public|private|protected|final|static|transient|volatile)\s+)*)([\w.<>\[\]?,\s]+?)\s+(\w+)\s*(?:=.*)?$`)
+	reKtFun       = regexp.MustCompile(`^((?:(?:public|private|protected|internal|abstract|final|open|override|suspend|inline|operator|infix|tailrec|external)\s+)*)fun\s+(?:<[^>]*>\s*)?(?:[\w.<>?]+\.)?(\w+)\s*\(`)
+	reKtProperty  = regexp.MustCompile(`^((?:(?:public|private|protected|internal|override|open|lateinit|const|abstract|final)\s+)*)(val|var)\s+(\w+)\s*(?::\s*([^=]+?))?\s*(?:=.*|by\s+.*)?$`)
+	reKtCtorParam = regexp.MustCompile(`^((?:(?:public|private|protected|internal|override|open)\s+)*)(val|var)\s+(\w+)\s*:\s*([^=]+?)\s*(?:=.*)?$`)
+)
+
+// readJVM membuat outline type top-level .java/.kt dan inventaris Spring.
+func readJVM(root string) ([]JavaClassFile, *SpringCtx) {
+	var out []JavaClassFile
+	walkFiles(root, func(full, rel string) {
+		lang := ""
+		switch {
+		case strings.HasSuffix(rel, ".java"):
+			lang = "java"
+		case strings.HasSuffix(rel, ".kt"):
+			lang = "kotlin"
+		default:
+			return
+		}
+		if strings.Contains(rel, "/test/") || strings.HasPrefix(rel, "test/") {
+			return
+		}
+		b, err := os.ReadFile(full)
+		if err != nil || len(b) > 512<<10 {
+			return
+		}
+		out = append(out, parseJVMFile(string(b), rel, lang)...)
+	})
+	if len(out) == 0 {
+		return nil, nil
+	}
+	slices.SortStableFunc(out, func(a, b JavaClassFile) int { return strings.Compare(a.Path, b.Path) })
+	return out, springCtx(out)
+}
+
+// jvmMember adalah satu deklarasi (header + body opsional) beserta anotasinya.
+type jvmMember struct {
+	Annos   []tsDecorator
+	Text    string // header sebelum `{` / `;`
+	Body    string
+	BodyOff int
+	Off     int
+}
+
+// jvmMembers memecah src (isi file atau body class) menjadi deklarasi di depth 0.
+// Pada Kotlin, newline juga mengakhiri deklarasi kecuali jelas berlanjut; pada C#
+// atribut `[Attr(..)]` dibaca seperti anotasi.
+func jvmMembers(src string, base int, lang string) []jvmMember {
+	var out []jvmMember
+	var annos []tsDecorator
+	i := 0
+	for i < len(src) {
+		for i < len(src) && strings.ContainsRune(" \t\r\n;,", rune(src[i])) {
+			i++
+		}
+		if i >= len(src) {
+			break
+		}
+		if lang == "csharp" && src[i] == '[' {
+			end := matchClose(src, i)
+			if end < 0 {
+				break
+			}
+			for _, a := range splitTopLevel(src[i+1:end], ',') {
+				a = strings.TrimSpace(a)
+				if _, after, ok := strings.Cut(a, ":"); ok && !strings.Contains(a[:strings.IndexByte(a, ':')], "(") {
+					a = strings.TrimSpace(after) // [return: X], [assembly: X]
+				}
+				d := tsDecorator{Name: a}
+				if p := strings.IndexByte(a, '('); p > 0 {
+					d.Name = strings.TrimSpace(a[:p])
+					d.Args, _ = jsCallArgs(a, p)
+				}
+				d.Name = strings.TrimSuffix(d.Name[strings.LastIndexByte(d.Name, '.')+1:], "Attribute")
+				annos = append(annos, d)
+			}
+			i = end + 1
+			continue
+		}
+		if src[i] == '@' && lang != "csharp" && !strings.HasPrefix(src[i:], "@interface") {
+			m := reJVMAnno.FindStringSubmatch(src[i:])
+			if m == nil {
+				i++
+				continue
+			}
+			d := tsDecorator{Name: m[1][strings.LastIndexByte(m[1], '.')+1:]}
+			i += len(m[0])
+			if i < len(src) && src[i] == '(' {
+				args, end := jsCallArgs(src, i)
+				if end < 0 {
+					break
+				}
+				d.Args = args
+				i = end + 1
+			}
+			annos = append(annos, d)
+			continue
+		}
+		start := i
+		mem := jvmMember{Annos: annos, Off: base + start}
+		depth := 0
+	scan:
+		for ; i < len(src); i++ {
+			switch c := src[i]; c {
+			case '"', '\'':
+				for i++; i < len(src) && src[i] != c; i++ {
+					if src[i] == '\\' {
+						i++
+					}
+				}
+			case '(', '[':
+				depth++
+			case ')', ']':
+				depth--
+			case '{':
+				end := matchClose(src, i)
+				if end < 0 {
+					i = len(src)
+					break scan
+				}
+				head := strings.TrimSpace(src[start:i])
+				if depth == 0 && !strings.HasSuffix(head, "=") && !strings.HasSuffix(head, "->") && !strings.HasSuffix(head, "=>") && !strings.HasSuffix(head, "by lazy") && !strings.HasSuffix(head, "new") && !strings.HasSuffix(head, "]") {
+					mem.Text = head
+					mem.Body = src[i+1 : end]
+					mem.BodyOff = base + i + 1
+					i = end + 1
+					break scan
+				}
+				i = end
+			case ';':
+				if depth <= 0 {
+					break scan
+				}
+			case '\n':
+				if lang == "kotlin" && depth <= 0 && !jvmContinues(src[start:i], src[i:]) {
+					break scan
+				}
+			}
+		}
+		if mem.Text == "" {
+			mem.Text = strings.TrimSpace(src[start:min(i, len(src))])
+		}
+		mem.Text = strings.Join(strings.Fields(mem.Text), " ")
+		out = append(out, mem)
+		annos = nil
+	}
+	return out
+}
+
+// jvmContinues: deklarasi Kotlin berlanjut ke baris berikut?
+func jvmContinues(cur, next string) bool {
+	cur = strings.TrimSpace(cur)
+	next = strings.TrimSpace(next)
+	if cur == "" {
+		return true
+	}
+	for _, suf := range []string{"=", ",", ":", "(", ".", "->", "&&", "||", "+"} {
+		if strings.HasSuffix(cur, suf) {
+			return true
+		}
+	}
+	for _, pre := range []string{"{", ".", ":", "=", "?:", "?.", "where "} {
+		if strings.HasPrefix(next, pre) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseJVMFile(src, rel, lang string) []JavaClassFile {
+	src = jsStripComments(src)
+	kotlin := lang == "kotlin"
+	pkg := ""
+	if m := reJVMPackage.FindStringSubmatch(src); m != nil {
+		pkg = m[1]
+	}
+	var out []JavaClassFile
+	for _, mem := range jvmMembers(src, 0, lang) {
+		m := reJVMTypeDecl.FindStringSubmatch(mem.Text)
+		if m == nil {
+			continue
+		}
+		c := JavaClassFile{Path: rel, Lang: lang, Package: pkg, Kind: m[2], Name: m[3], Line: lineAt(src, mem.Off), annos: mem.Annos}
+		c.Modifiers = strings.Fields(m[1])
+		switch {
+		case c.Kind == "@interface" || slices.Contains(c.Modifiers, "annotation"):
+			c.Kind = "annotation"
+		case slices.Contains(c.Modifiers, "enum"):
+			c.Kind = "enum"
+		}
+		c.Modifiers = slices.DeleteFunc(c.Modifiers, func(s string) bool { return s == "enum" || s == "annotation" })
+		for _, a := range mem.Annos {
+			c.Annotations = append(c.Annotations, jvmAnnoText(a))
+		}
+		rest := strings.TrimSpace(mem.Text[len(m[0]):])
+		if strings.HasPrefix(rest, "<") {
+			if end := jvmAngleClose(rest); end > 0 {
+				rest = strings.TrimSpace(rest[end+1:])
+			}
+		}
+		var ctor string
+		if strings.HasPrefix(rest, "(") || strings.HasPrefix(rest, "constructor") || strings.HasPrefix(rest, "private constructor") {
+			// primary constructor Kotlin / komponen record
+			if p := strings.IndexByte(rest, '('); p >= 0 {
+				if end := matchClose(rest, p); end > 0 {
+					ctor = rest[p+1 : end]
+					rest = strings.TrimSpace(rest[end+1:])
+				}
+			}
+		}
+		jvmSupertypes(&c, rest, kotlin)
+		if ctor != "" {
+			for _, p := range splitTopLevel(ctor, ',') {
+				p = strings.Join(strings.Fields(p), " ")
+				var annos []string
+				for strings.HasPrefix(p, "@") {
+					j := len(reJVMAnno.FindString(p))
+					if j < len(p) && p[j] == '(' {
+						j = matchClose(p, j) + 1
+					}
+					if j <= 1 {
+						break
+					}
+					annos = append(annos, p[:j])
+					p = strings.TrimSpace(p[j:])
+				}
+				if pm := reKtCtorParam.FindStringSubmatch(p); pm != nil {
+					c.Fields = append(c.Fields, JavaField{Name: pm[3], Type: pm[4], Line: c.Line, Annotations: annos})
+				} else if c.Kind == "record" {
+					if f := strings.Fields(p); len(f) >= 2 {
+						c.Fields = append(c.Fields, JavaField{Name: f[len(f)-1], Type: strings.Join(f[:len(f)-1], " "), Line: c.Line, Annotations: annos})
+					}
+				}
+			}
+		}
+		jvmClassBody(&c, src, mem, kotlin)
+		jvmStereotype(&c)
+		out = append(out, c)
+	}
+	return out
+}
+
+// jvmSupertypes membaca `extends A implements B, C` (Java) atau `: A(), B` (Kotlin).
+func jvmSupertypes(c *JavaClassFile, rest string, kotlin bool) {
+	if kotlin {
+		if !strings.HasPrefix(rest, ":") {
+			return
+		}
+		rest = strings.TrimSpace(rest[1:])
+		if i := strings.Index(rest, " where "); i >= 0 {
+			rest = rest[:i]
+		}
+		for _, t := range jvmSplitTypes(rest) {
+			if i := strings.Index(t, " by "); i >= 0 {
+				t = t[:i]
+			}
+			if i := strings.IndexByte(t, '('); i >= 0 {
+				c.Extends = append(c.Extends, strings.TrimSpace(t[:i]))
+			} else if c.Kind == "interface" {
+				c.Extends = append(c.Extends, t)
+			} else {
+				c.Implements = append(c.Implements, t)
+			}
+		}
+		return
+	}
+	low := " " + rest + " "
+	take := func(kw string) []string {
+		i := strings.Index(low, " "+kw+" ")
+		if i < 0 {
+			return nil
+		}
+		s := low[i+len(kw)+2:]
+		for _, stop := range []string{" implements ", " extends ", " permits "} {
+			if j := strings.Index(s, stop); j >= 0 {
+				s = s[:j]
+			}
+		}
+		return jvmSplitTypes(s)
+	}
+	c.Extends = take("extends")
+	c.Implements = take("implements")
+}
+
+// jvmClassBody mengisi field dan method public dari body class.
+func jvmClassBody(c *JavaClassFile, src string, mem jvmMember, kotlin bool) {
+	publicByDefault := kotlin || c.Kind == "interface" || c.Kind == "annotation"
+	for i, mm := range jvmMembers(mem.Body, mem.BodyOff, c.Lang) {
+		t := mm.Text
+		if i == 0 && c.Kind == "enum" && mm.Body == "" {
+			// konstanta enum: deklarasi pertama di body
+			for _, k := range splitTopLevel(t, ',') {
+				if k = strings.TrimSpace(k); k != "" {
+					if j := strings.IndexAny(k, "( "); j > 0 {
+						k = k[:j]
+					}
+					c.Fields = append(c.Fields, JavaField{Name: k, Line: lineAt(src, mm.Off)})
+				}
+			}
+			continue
+		}
+		var annos []string
+		for _, a := range mm.Annos {
+			annos = append(annos, jvmAnnoText(a))
+		}
+		line := lineAt(src, mm.Off)
+		if kotlin {
+			if m := reKtFun.FindStringSubmatch(t); m != nil {
+				mods := strings.Fields(m[1])
+				if slices.Contains(mods, "private") || slices.Contains(mods, "protected") || slices.Contains(mods, "internal") {
+					continue
+				}
+				c.Methods = append(c.Methods, JavaMethod{Name: m[2], Signature: jvmSignature(t[len(m[1]):]), Line: line, Annotations: annos, annos: mm.Annos})
+			} else if m := reKtProperty.FindStringSubmatch(t); m != nil {
+				c.Fields = append(c.Fields, JavaField{Name: m[3], Type: strings.TrimSpace(m[4]), Line: line, Annotations: annos})
+			}
+			continue
+		}
+		if reJVMTypeDecl.MatchString(t) || strings.HasPrefix(t, "static") && mm.Body != "" && !strings.Contains(t, "(") {
+			continue // nested type / static initializer
+		}
+		if m := reJavaMethod.FindStringSubmatch(t); m != nil && !strings.Contains(t[:strings.IndexByte(t, '(')], "=") {
+			mods := strings.Fields(m[1])
+			if m[2] == "new" || m[2] == "return" {
+				continue
+			}
+			if slices.Contains(mods, "public") || (publicByDefault && !slices.Contains(mods, "private")) {
+				c.Methods = append(c.Methods, JavaMethod{Name: m[3], Signature: jvmSignature(t[len(m[1]):]), Line: line, Annotations: annos, annos: mm.Annos})
+			}
+			continue
+		}
+		if m := reJavaField.FindStringSubmatch(t); m != nil && mm.Body == "" {
+			mods := strings.Fields(m[1])
+			if slices.Contains(mods, "static") {
+				continue
+			}
+			c.Fields = append(c.Fields, JavaField{Name: m[3], Type: strings.TrimSpace(m[2]), Line: line, Annotations: annos})
+		}
+	}
+}
+
+// jvmSignature meringkas header method: buang `throws` dan body ekspresi Kotlin.
+func jvmSignature(t string) string {
+	if p := strings.IndexByte(t, '('); p >= 0 {
+		if end := matchClose(t, p); end > 0 {
+			tail := t[end+1:]
+			if i := strings.Index(tail, " throws "); i >= 0 {
+				tail = tail[:i]
+			}
+			if i := strings.Index(tail, "="); i >= 0 {
+				tail = tail[:i]
+			}
+			t = t[:end+1] + strings.TrimRight(tail, " ")
+		}
+	}
+	t = strings.NewReplacer("( ", "(", " )", ")").Replace(strings.TrimPrefix(strings.TrimSpace(t), "fun "))
+	return truncate(t, 200)
+}
+
+// jvmSplitTypes memecah daftar tipe dengan koma di luar <> dan ().
+func jvmSplitTypes(s string) []string {
+	var out []string
+	depth, start := 0, 0
+	for i := 0; i <= len(s); i++ {
+		if i < len(s) {
+			switch s[i] {
+			case '<', '(':
+				depth++
+				continue
+			case '>', ')':
+				depth--
+				continue
+			case ',':
+				if depth > 0 {
+					continue
+				}
+			default:
+				continue
+			}
+		}
+		if t := strings.TrimSpace(s[start:i]); t != "" {
+			out = append(out, t)
+		}
+		start = i + 1
+	}
+	return out
+}
+
+func jvmAngleClose(s string) int {
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '<':
+			depth++
+		case '>':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func jvmAnnoText(a tsDecorator) string {
+	if len(a.Args) == 0 {
+		return "@" + a.Name
+	}
+	return "@" + a.Name + "(" + truncate(strings.Join(strings.Fields(strings.Join(a.Args, ", ")), " "), 120) + ")"
+}
+
+// jvmAnnoArg mengambil nilai argumen anotasi: positional pertama atau key=value.
+// Array `{"a", "b"}` / `["a"]` diambil elemen pertamanya.
+func jvmAnnoArg(a tsDecorator, keys ...string) string {
+	for i, arg := range a.Args {
+		k, v, ok := strings.Cut(arg, "=")
+		k = strings.TrimSpace(k)
+		if !ok || strings.ContainsAny(k, "\"(") {
+			if i == 0 && (slices.Contains(keys, "value") || slices.Contains(keys, "")) {
+				return jvmAnnoValue(arg)
+			}
+			continue
+		}
+		if slices.Contains(keys, k) {
+			return jvmAnnoValue(v)
+		}
+	}
+	return ""
+}
+
+func jvmAnnoValue(v string) string {
+	v = strings.TrimSpace(v)
+	if strings.HasPrefix(v, "{") || strings.HasPrefix(v, "[") {
+		parts := splitTopLevel(v[1:max(len(v)-1, 1)], ',')
+		if len(parts) == 0 {
+			return ""
+		}
+		v = strings.TrimSpace(parts[0])
+	}
+	return strings.Trim(v, `"`)
+}
+
+// ================= Spring =================
+
+var springStereotypes = map[string]string{
+	"RestController": "controller", "Controller": "controller",
+	"Service": "service", "Repository": "repository", "Component": "component",
+	"Entity": "entity", "Configuration": "configuration", "SpringBootApplication": "application",
+}
+
+func jvmStereotype(c *JavaClassFile) {
+	for _, a := range c.annos {
+		if st, ok := springStereotypes[a.Name]; ok && c.Stereotype == "" {
+			c.Stereotype = st
+		}
+		if a.Name == "Table" {
+			c.Table = jvmAnnoArg(a, "name")
+		}
+	}
+	if c.Stereotype == "" && c.Kind == "interface" {
+		for _, e := range c.Extends {
+			if hasAnyPrefix(e, "JpaRepository", "CrudRepository", "PagingAndSortingRepository", "MongoRepository", "ReactiveCrudRepository", "Repository<", "ListCrudRepository", "R2dbcRepository") {
+				c.Stereotype = "repository"
+			}
+		}
+	}
+}
+
+var springMappings = map[string]string{
+	"GetMapping": "GET", "PostMapping": "POST", "PutMapping": "PUT", "PatchMapping": "PATCH", "DeleteMapping": "DELETE", "RequestMapping": "",
+}
+
+// springCtx menyusun inventaris Spring dari outline JVM.
+func springCtx(files []JavaClassFile) *SpringCtx {
+	sc := &SpringCtx{}
+	for _, c := range files {
+		fqcn := c.Name
+		if c.Package != "" {
+			fqcn = c.Package + "." + c.Name
+		}
+		switch c.Stereotype {
+		case "application":
+			sc.Application = fqcn
+		case "controller":
+			sc.Controllers = append(sc.Controllers, fqcn)
+		case "service":
+			sc.Services = append(sc.Services, fqcn)
+		case "repository":
+			sc.Repositories = append(sc.Repositories, fqcn)
+		case "component":
+			sc.Components = append(sc.Components, fqcn)
+		case "entity":
+			sc.Entities = append(sc.Entities, fqcn)
+		case "configuration":
+			sc.Configurations = append(sc.Configurations, fqcn)
+		}
+		base := ""
+		for _, a := range c.annos {
+			if a.Name == "RequestMapping" {
+				base = jvmAnnoArg(a, "value", "path")
+			}
+		}
+		for _, m := range c.Methods {
+			for _, a := range m.annos {
+				task := SpringTask{Class: fqcn, Method: m.Name, File: c.Path, Line: m.Line}
+				switch a.Name {
+				case "Bean":
+					sc.Beans = append(sc.Beans, c.Name+"#"+m.Name)
+				case "Scheduled":
+					for _, k := range []string{"cron", "fixedRate", "fixedDelay", "fixedRateString", "fixedDelayString"} {
+						if v := jvmAnnoArg(a, k); v != "" {
+							task.Kind, task.Trigger = strings.TrimSuffix(k, "String"), v
+							break
+						}
+					}
+					sc.Scheduled = append(sc.Scheduled, task)
+				case "KafkaListener":
+					task.Kind, task.Trigger = "kafka", jvmAnnoArg(a, "topics", "topicPattern")
+					sc.Listeners = append(sc.Listeners, task)
+				case "RabbitListener":
+					task.Kind, task.Trigger = "rabbit", jvmAnnoArg(a, "queues")
+					sc.Listeners = append(sc.Listeners, task)
+				case "JmsListener":
+					task.Kind, task.Trigger = "jms", jvmAnnoArg(a, "destination")
+					sc.Listeners = append(sc.Listeners, task)
+				case "EventListener", "TransactionalEventListener":
+					task.Kind = "event"
+					if p := strings.IndexByte(m.Signature, '('); p >= 0 {
+						// tipe event = tipe parameter pertama
+						if params := splitTopLevel(m.Signature[p+1:max(strings.LastIndexByte(m.Signature, ')'), p+1)], ','); len(params) > 0 {
+							task.Trigger = jvmParamType(params[0])
+						}
+					}
+					sc.Listeners = append(sc.Listeners, task)
+				}
+				verb, ok := springMappings[a.Name]
+				if !ok || c.Stereotype != "controller" {
+					continue
+				}
+				if verb == "" {
+					verb = strings.TrimPrefix(jvmAnnoArg(a, "method"), "RequestMethod.")
+					if verb == "" {
+						verb = "ANY"
+					}
+				}
+				ep := RouteEndpoint{Method: verb, Path: joinPath(base, jvmAnnoArg(a, "value", "path")), Controller: fqcn, Action: m.Name}
+				if p := strings.IndexByte(m.Signature, '('); p >= 0 {
+					for _, param := range splitTopLevel(m.Signature[p+1:max(strings.LastIndexByte(m.Signature, ')'), p+1)], ',') {
+						if strings.Contains(param, "@RequestBody") {
+							ep.Request = jvmParamType(param)
+						}
+					}
+				}
+				sc.Endpoints = append(sc.Endpoints, ep)
+			}
+		}
+	}
+	if sc.Application == "" && len(sc.Controllers)+len(sc.Services)+len(sc.Repositories)+len(sc.Components)+len(sc.Entities)+len(sc.Configurations)+len(sc.Listeners)+len(sc.Scheduled) == 0 {
+		return nil
+	}
+	return sc
+}
+
+// jvmParamType: "@RequestBody @Valid CreateUser req" -> CreateUser, "req: CreateUser" -> CreateUser.
+func jvmParamType(p string) string {
+	var f []string
+	for _, w := range strings.Fields(p) {
+		if !strings.HasPrefix(w, "@") && w != "final" {
+			f = append(f, w)
+		}
+	}
+	s := strings.Join(f, " ")
+	if _, t, ok := strings.Cut(s, ":"); ok {
+		return strings.TrimSpace(t)
+	}
+	if len(f) >= 2 {
+		return strings.Join(f[:len(f)-1], " ")
+	}
+	return s
+}
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// bucket memetakan path file di app/ ke daftar LaravelCtx yang sesuai.
+func (l *LaravelCtx) bucket(rel string) *[]PHPClassFile {
+	switch {
+	case strings.HasPrefix(rel, "app/Http/Controllers/"):
+		return &l.Controllers
+	case strings.HasPrefix(rel, "app/Http/Middleware/"):
+		return &l.Middleware
+	case strings.HasPrefix(rel, "app/Http/Requests/"):
+		return &l.Requests
+	case strings.HasPrefix(rel, "app/Http/Resources/"):
+		return &l.Resources
+	case strings.HasPrefix(rel, "app/Http/Livewire/"), strings.HasPrefix(rel, "app/Livewire/"):
+		return &l.Livewire
+	case strings.HasPrefix(rel, "app/Models/"):
+		return &l.Models
+	case strings.HasPrefix(rel, "app/Traits/"):
+		return &l.Traits
+	case strings.HasPrefix(rel, "app/Helpers/"):
+		return &l.Helpers
+	case strings.HasPrefix(rel, "app/Jobs/"):
+		return &l.Jobs
+	case strings.HasPrefix(rel, "app/Events/"):
+		return &l.Events
+	case strings.HasPrefix(rel, "app/Listeners/"):
+		return &l.Listeners
+	case strings.HasPrefix(rel, "app/Mail/"):
+		return &l.Mail
+	case strings.HasPrefix(rel, "app/Notifications/"):
+		return &l.Notifications
+	case strings.HasPrefix(rel, "app/Policies/"):
+		return &l.Policies
+	case strings.HasPrefix(rel, "app/Console/Commands/"):
+		return &l.Commands
+	case strings.HasPrefix(rel, "app/Providers/"):
+		return &l.Providers
+	case strings.HasPrefix(rel, "app/Observers/"):
+		return &l.Observers
+	case strings.HasPrefix(rel, "app/Filament/"):
+		return &l.Filament
+	}
+	return nil
+}
+
+func (l *LaravelCtx) buckets() []*[]PHPClassFile {
+	return []*[]PHPClassFile{
+		&l.Controllers, &l.Middleware, &l.Models, &l.Traits, &l.Helpers,
+		&l.Jobs, &l.Events, &l.Listeners, &l.Mail, &l.Notifications, &l.Policies,
+		&l.Requests, &l.Resources, &l.Commands, &l.Providers, &l.Observers, &l.Livewire, &l.Filament,
+	}
+}
+
+// parseCommand membaca console command beserta $signature/$description.
+func parseCommand(full string) PHPClassFile {
+	o := readPHPOutline(full)
+	if o == nil {
+		return PHPClassFile{}
+	}
+	pc := o.file
+	if v, ok := o.propValue("signature"); ok {
+		pc.Signature = strings.Join(strings.Fields(phpString(v)), " ")
+	}
+	if v, ok := o.propValue("description"); ok {
+		pc.Description = phpString(v)
+	}
+	return pc
+}
+
+// laravelEventMap menggabungkan $listen di service provider, Event::listen(...)
+// dan listener yang di-discover dari type-hint handle(Event $e).
+func laravelEventMap(root string, l *LaravelCtx) map[string][]string {
+	out := map[string][]string{}
+	add := func(event, listener string) {
+		if event == "" || listener == "" {
+			return
+		}
+		for _, x := range out[event] {
+			if x == listener {
+				return
+			}
+		}
+		out[event] = append(out[event], listener)
+	}
+	for _, p := range l.Providers {
+		o := readPHPOutline(filepath.Join(root, p.Path))
+		if o == nil {
+			continue
+		}
+		if v, ok := o.propValue("listen"); ok {
+			if arr, ok := v.(phpArray); ok {
+				for _, e := range arr {
+					for _, lis := range phpStrings(e.Val) {
+						add(e.Key, lis)
+					}
+				}
+			}
+		}
+		toks := o.toks
+		for k := 0; k+2 < len(toks); k++ {
+			if toks[k].kind != 'n' || (toks[k].val != "Event" && !strings.HasSuffix(toks[k].val, `\Event`)) || toks[k+1].val != "::" {
+				continue
+			}
+			calls, next := o.chain(k+1, len(toks)-1)
+			if len(calls) > 0 && calls[0].Name == "listen" && len(calls[0].Args) >= 2 {
+				event := phpString(calls[0].Args[0])
+				switch lis := calls[0].Args[1].(type) {
+				case phpArray:
+					// [Listener::class, 'handle']
+					if len(lis) > 0 {
+						add(event, phpString(lis[0].Val))
+					}
+				case phpClassRef, string:
+					add(event, phpString(lis))
+				}
+			}
+			k = next - 1
+		}
+	}
+	for _, lis := range l.Listeners {
+		fq := lis.Class
+		if lis.Namespace != "" {
+			fq = lis.Namespace + `\` + lis.Class
+		}
+		for _, m := range lis.Methods {
+			if (m.Name == "handle" || m.Name == "__invoke") && len(m.Params) > 0 && m.Params[0].Type != "" {
+				for _, t := range strings.Split(strings.TrimPrefix(m.Params[0].Type, "?"), "|") {
+					add(lis.resolvePHPName(t), fq)
+				}
+			}
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+// readLaravelSchedule membaca jadwal dari routes/console.php (Laravel 11+),
+// bootstrap/app.php (withSchedule) dan app/Console/Kernel.php.
+func readLaravelSchedule(root string) []LaravelSchedule {
+	var out []LaravelSchedule
+	for _, rel := range []string{"routes/console.php", "bootstrap/app.php", "app/Console/Kernel.php"} {
+		full := filepath.Join(root, rel)
+		if !exists(full) {
+			continue
+		}
+		o := readPHPOutline(full)
+		if o == nil {
+			continue
+		}
+		toks := o.toks
+		for k := 0; k+2 < len(toks); k++ {
+			t := toks[k]
+			static := t.kind == 'n' && (t.val == "Schedule" || strings.HasSuffix(t.val, `\Schedule`)) && toks[k+1].val == "::"
+			inst := t.kind == 'v' && t.val == "schedule" && toks[k+1].val == "->"
+			if !static && !inst {
+				continue
+			}
+			calls, next := o.chain(k+1, len(toks)-1)
+			k = next - 1
+			if len(calls) == 0 {
+				continue
+			}
+			sc := LaravelSchedule{Type: calls[0].Name, Source: rel}
+			switch sc.Type {
+			case "command", "exec":
+				if len(calls[0].Args) > 0 {
+					sc.Target = phpString(calls[0].Args[0])
+				}
+			case "job":
+				if len(calls[0].Args) > 0 {
+					sc.Target = strings.TrimPrefix(phpString(calls[0].Args[0]), "new ")
+					if i := strings.IndexByte(sc.Target, '('); i > 0 {
+						sc.Target = sc.Target[:i]
+					}
+				}
+			case "call", "invoke":
+				sc.Type = "call"
+				sc.Target = "closure"
+				if len(calls[0].Args) > 0 {
+					if _, ok := calls[0].Args[0].(phpRaw); !ok {
+						sc.Target = phpString(calls[0].Args[0])
+					}
+				}
+			default:
+				continue
+			}
+			var freq []string
+			for _, c := range calls[1:] {
+				freq = append(freq, o.callText(c))
+			}
+			sc.Frequency = strings.Join(freq, "->")
+			out = append(out, sc)
+		}
+	}
+	return out
+}
+
+// callText merender call chain kembali ke bentuk source ringkas, mis. dailyAt('02:00').
+func (o *phpOutline) callText(c phpChainCall) string {
+	if c.args == [2]int{} {
+		return c.Name // property access, bukan call
+	}
+	if c.args[1] < c.args[0] {
+		return c.Name + "()"
+	}
+	return c.Name + "(" + o.text(c.args[0], c.args[1]) + ")"
+}
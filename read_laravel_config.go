@@ -0,0 +1,126 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var (
+	reEnvCall = regexp.MustCompile(`\benv\(\s*['"]([A-Za-z0-9_]+)['"]\s*(?:,\s*(.+?))?\)`)
+	reEnvName = regexp.MustCompile(`\benv\(\s*['"]?([A-Za-z0-9_]+)`)
+)
+
+// readLaravelConfig membaca config/*.php menjadi daftar key bertitik beserta env()
+// yang mengisinya, plus peta balik env var -> config key.
+func readLaravelConfig(root string) ([]LaravelConfigKey, map[string][]string) {
+	dir := filepath.Join(root, "config")
+	if !exists(dir) {
+		return nil, nil
+	}
+	var keys []LaravelConfigKey
+	_ = filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(path, ".php") {
+			return nil
+		}
+		relDir, _ := filepath.Rel(dir, path)
+		// config/services/foo.php -> services.foo (sama seperti LoadConfiguration Laravel)
+		prefix := strings.ReplaceAll(strings.TrimSuffix(filepath.ToSlash(relDir), ".php"), "/", ".")
+		o := readPHPOutline(path)
+		if o == nil {
+			return nil
+		}
+		v, ok := o.fileReturn()
+		if !ok {
+			return nil
+		}
+		rel, _ := filepath.Rel(root, path)
+		keys = appendConfigKeys(keys, prefix, v, filepath.ToSlash(rel))
+		return nil
+	})
+	slices.SortStableFunc(keys, func(a, b LaravelConfigKey) int { return strings.Compare(a.Key, b.Key) })
+
+	env := map[string][]string{}
+	for _, k := range keys {
+		if k.Env != "" {
+			env[k.Env] = append(env[k.Env], k.Key)
+		}
+		// env fallback di dalam default, mis. env('DATABASE_URL', env('DB_URL'))
+		for _, m := range reEnvName.FindAllStringSubmatch(k.Default, -1) {
+			if !slices.Contains(env[m[1]], k.Key) {
+				env[m[1]] = append(env[m[1]], k.Key)
+			}
+		}
+	}
+	if len(env) == 0 {
+		env = nil
+	}
+	return keys, env
+}
+
+// fileReturn mengevaluasi `return [...]` di level teratas file (bukan di dalam class/function).
+func (o *phpOutline) fileReturn() (any, bool) {
+	depth := 0
+	for k, t := range o.toks {
+		switch t.val {
+		case "{", "(", "[":
+			depth++
+		case "}", ")", "]":
+			depth--
+		}
+		if depth == 0 && t.kind == 'n' && strings.EqualFold(t.val, "return") {
+			end := o.skipToSemicolon(k+1, len(o.toks))
+			return o.value(k+1, end-1), true
+		}
+	}
+	return nil, false
+}
+
+func appendConfigKeys(out []LaravelConfigKey, key string, v any, file string) []LaravelConfigKey {
+	if arr, ok := v.(phpArray); ok && len(arr) > 0 && arr[0].HasKey {
+		for _, e := range arr {
+			if e.HasKey {
+				out = appendConfigKeys(out, key+"."+e.Key, e.Val, file)
+			}
+		}
+		return out
+	}
+	ck := LaravelConfigKey{Key: key, File: file}
+	if name, def, ok := envRef(v); ok {
+		ck.Env = name
+		ck.Default = def
+	} else {
+		ck.Value = phpString(v)
+	}
+	return append(out, ck)
+}
+
+// envRef mencari env('X', default) di dalam ekspresi, termasuk yang terbungkus
+// cast atau pemanggilan lain seperti explode(',', env('X')).
+func envRef(v any) (name, def string, ok bool) {
+	switch x := v.(type) {
+	case phpCall:
+		if strings.EqualFold(x.Name, "env") || strings.HasSuffix(x.Name, "Env::get") {
+			if len(x.Args) == 0 {
+				return "", "", false
+			}
+			name = phpString(x.Args[0])
+			if len(x.Args) > 1 {
+				def = phpString(x.Args[1]) // env('A', env('B')) -> "env(B)"
+			}
+			return name, def, true
+		}
+		for _, a := range x.Args {
+			if n, d, ok := envRef(a); ok {
+				return n, d, true
+			}
+		}
+	case phpRaw:
+		if m := reEnvCall.FindStringSubmatch(string(x)); m != nil {
+			return m[1], trimQuotes(m[2]), true
+		}
+	}
+	return "", "", false
+}
@@ -0,0 +1,498 @@
+package main
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// tipe kolom Blueprint -> tipe yang dicatat di schema
+var laravelColumnTypes = map[string]string{
+	"string": "string", "char": "char", "text": "text", "tinyText": "tinyText", "mediumText": "mediumText", "longText": "longText",
+	"integer": "integer", "tinyInteger": "tinyInteger", "smallInteger": "smallInteger", "mediumInteger": "mediumInteger", "bigInteger": "bigInteger",
+	"float": "float", "double": "double", "decimal": "decimal", "boolean": "boolean",
+	"date": "date", "dateTime": "dateTime", "dateTimeTz": "dateTimeTz", "time": "time", "timeTz": "timeTz",
+	"timestamp": "timestamp", "timestampTz": "timestampTz", "year": "year",
+	"binary": "binary", "json": "json", "jsonb": "jsonb", "uuid": "uuid", "ulid": "ulid",
+	"ipAddress": "ipAddress", "macAddress": "macAddress", "enum": "enum", "set": "set",
+	"geometry": "geometry", "geography": "geography", "point": "point", "lineString": "lineString", "polygon": "polygon",
+	"multiPoint": "multiPoint", "multiLineString": "multiLineString", "multiPolygon": "multiPolygon", "vector": "vector",
+	"unsignedInteger": "integer", "unsignedTinyInteger": "tinyInteger", "unsignedSmallInteger": "smallInteger",
+	"unsignedMediumInteger": "mediumInteger", "unsignedBigInteger": "bigInteger", "unsignedDecimal": "decimal",
+	"increments": "integer", "tinyIncrements": "tinyInteger", "smallIncrements": "smallInteger",
+	"mediumIncrements": "mediumInteger", "bigIncrements": "bigInteger", "integerIncrements": "integer",
+	"foreignId": "bigInteger", "foreignUuid": "uuid", "foreignUlid": "ulid",
+}
+
+// readLaravelSchema me-replay Schema::create/table/drop di method up() setiap migration
+// (urut nama file) menjadi schema akhir.
+func readLaravelSchema(root string, migrations []string, withER bool) *DBSchema {
+	s := &DBSchema{Source: "laravel"}
+	for _, rel := range migrations {
+		if !strings.HasPrefix(rel, "database/migrations/") || !strings.HasSuffix(rel, ".php") {
+			continue
+		}
+		o := readPHPOutline(filepath.Join(root, rel))
+		if o == nil {
+			continue
+		}
+		r, ok := o.functionBody("up")
+		if !ok {
+			continue
+		}
+		if replayLaravelMigration(s, o, r) {
+			s.Files = append(s.Files, rel)
+		}
+	}
+	if len(s.Tables) == 0 {
+		return nil
+	}
+	if withER {
+		s.Mermaid = mermaidER(s)
+	}
+	return s
+}
+
+func replayLaravelMigration(s *DBSchema, o *phpOutline, r [2]int) bool {
+	toks := o.toks
+	touched := false
+	for k := r[0]; k <= r[1]; k++ {
+		t := toks[k]
+		if t.kind != 'n' || (t.val != "Schema" && !strings.HasSuffix(t.val, `\Schema`)) || k+1 > r[1] || toks[k+1].val != "::" {
+			continue
+		}
+		calls, next := o.chain(k+1, r[1])
+		for _, c := range calls {
+			arg := func(i int) string {
+				if i < len(c.Args) {
+					return phpString(c.Args[i])
+				}
+				return ""
+			}
+			switch c.Name {
+			case "create", "table":
+				table := arg(0)
+				if table == "" {
+					continue
+				}
+				if c.Name == "create" {
+					s.dropTable(table)
+				}
+				tbl := s.table(table)
+				if body, bpVar, ok := o.closureBody(c.args); ok {
+					if !o.replayBlueprint(s, tbl, bpVar, body) {
+						s.dropTable(table)
+					}
+				}
+				touched = true
+			case "drop", "dropIfExists":
+				s.dropTable(arg(0))
+				touched = true
+			case "rename":
+				s.renameTable(arg(0), arg(1))
+				touched = true
+			case "dropColumns":
+				if tbl := s.lookup(arg(0)); tbl != nil {
+					for _, col := range phpArgStrings(c.Args[1:]) {
+						tbl.dropColumn(col)
+					}
+				}
+				touched = true
+			}
+		}
+		k = next - 1
+	}
+	return touched
+}
+
+// closureBody mencari `function (Blueprint $table) { ... }` di dalam argumen call.
+func (o *phpOutline) closureBody(args [2]int) ([2]int, string, bool) {
+	toks := o.toks
+	for k := args[0]; k <= args[1]; k++ {
+		if toks[k].kind != 'n' || !strings.EqualFold(toks[k].val, "function") || k+1 > args[1] || toks[k+1].val != "(" {
+			continue
+		}
+		close := o.skipBalanced(k+1, "(", ")")
+		bpVar := ""
+		for p := k + 2; p < close; p++ {
+			if toks[p].kind == 'v' {
+				bpVar = toks[p].val
+				break
+			}
+		}
+		for c := close + 1; c <= args[1]; c++ {
+			if toks[c].val == "{" {
+				return [2]int{c + 1, o.skipBalanced(c, "{", "}") - 1}, bpVar, true
+			}
+		}
+	}
+	return [2]int{}, "", false
+}
+
+// phpArgStrings meratakan argumen string/array menjadi daftar string.
+func phpArgStrings(args []any) []string {
+	var out []string
+	for _, a := range args {
+		if arr, ok := a.(phpArray); ok {
+			out = append(out, phpStrings(arr)...)
+		} else if a != nil {
+			out = append(out, phpString(a))
+		}
+	}
+	return out
+}
+
+func laravelIndexName(table string, cols []string, kind string) string {
+	name := strings.ToLower(table + "_" + strings.Join(cols, "_") + "_" + kind)
+	return strings.NewReplacer("-", "_", ".", "_").Replace(name)
+}
+
+// replayBlueprint menerapkan statement `$table->...` ke tabel. Mengembalikan false
+// jika tabel dihapus di dalam closure (mis. $table->drop()).
+func (o *phpOutline) replayBlueprint(s *DBSchema, tbl *DBTable, bpVar string, body [2]int) bool {
+	toks := o.toks
+	for _, st := range o.statements(body[0], body[1]) {
+		k := st[0]
+		if toks[k].kind != 'v' || toks[k].val != bpVar || k+1 > st[1] {
+			continue
+		}
+		calls, _ := o.chain(k+1, st[1])
+		if len(calls) == 0 {
+			continue
+		}
+		head := calls[0]
+		args := head.Args
+		arg := func(i int) string {
+			if i < len(args) {
+				return phpString(args[i])
+			}
+			return ""
+		}
+		cols := func(i int) []string {
+			if i < len(args) {
+				return phpArgStrings(args[i : i+1])
+			}
+			return nil
+		}
+		switch head.Name {
+		case "primary", "unique", "index", "fullText", "fulltext", "spatialIndex", "rawIndex":
+			ix := DBIndex{Columns: cols(0), Name: arg(1)}
+			kind := strings.ToLower(head.Name)
+			switch head.Name {
+			case "primary":
+				ix.Primary = true
+			case "unique":
+				ix.Unique = true
+			case "fullText", "fulltext":
+				ix.Kind = "fulltext"
+			case "spatialIndex":
+				ix.Kind = "spatial"
+			}
+			if ix.Name == "" {
+				ix.Name = laravelIndexName(tbl.Name, ix.Columns, kind)
+			}
+			tbl.addIndex(ix)
+		case "foreign":
+			fk := DBForeignKey{Columns: cols(0), Name: arg(1)}
+			if fk.Name == "" {
+				fk.Name = laravelIndexName(tbl.Name, fk.Columns, "foreign")
+			}
+			applyForeignModifiers(&fk, calls[1:])
+			tbl.ForeignKeys = append(tbl.ForeignKeys, fk)
+		case "dropColumn", "dropColumns":
+			for _, c := range phpArgStrings(args) {
+				tbl.dropColumn(c)
+			}
+		case "renameColumn":
+			tbl.renameColumn(arg(0), arg(1))
+		case "dropForeign":
+			if len(args) == 0 {
+				continue
+			}
+			if _, ok := args[0].(phpArray); ok {
+				tbl.dropForeign(laravelIndexName(tbl.Name, cols(0), "foreign"), cols(0))
+			} else {
+				tbl.dropForeign(arg(0), nil)
+			}
+		case "dropConstrainedForeignId":
+			tbl.dropForeign(laravelIndexName(tbl.Name, cols(0), "foreign"), cols(0))
+			tbl.dropColumn(arg(0))
+		case "dropPrimary":
+			for i := range tbl.Columns {
+				tbl.Columns[i].Primary = false
+			}
+			tbl.Indexes = slices.DeleteFunc(tbl.Indexes, func(ix DBIndex) bool { return ix.Primary })
+		case "dropUnique", "dropIndex", "dropFullText", "dropSpatialIndex":
+			if len(args) > 0 {
+				if _, ok := args[0].(phpArray); ok {
+					tbl.dropIndex("", cols(0))
+					continue
+				}
+			}
+			tbl.dropIndex(arg(0), nil)
+		case "renameIndex":
+			for i := range tbl.Indexes {
+				if strings.EqualFold(tbl.Indexes[i].Name, arg(0)) {
+					tbl.Indexes[i].Name = arg(1)
+				}
+			}
+		case "dropTimestamps", "dropTimestampsTz":
+			tbl.dropColumn("created_at")
+			tbl.dropColumn("updated_at")
+		case "dropSoftDeletes", "dropSoftDeletesTz":
+			col := arg(0)
+			if col == "" {
+				col = "deleted_at"
+			}
+			tbl.dropColumn(col)
+		case "dropRememberToken":
+			tbl.dropColumn("remember_token")
+		case "dropMorphs":
+			tbl.dropColumn(arg(0) + "_type")
+			tbl.dropColumn(arg(0) + "_id")
+		case "rename":
+			s.renameTable(tbl.Name, arg(0))
+		case "drop", "dropIfExists":
+			s.dropTable(tbl.Name)
+			return false
+		case "timestamps", "timestampsTz", "nullableTimestamps", "datetimes":
+			typ := "timestamp"
+			if strings.HasSuffix(head.Name, "Tz") {
+				typ = "timestampTz"
+			} else if head.Name == "datetimes" {
+				typ = "dateTime"
+			}
+			for _, n := range []string{"created_at", "updated_at"} {
+				tbl.setColumn(DBColumn{Name: n, Type: typ, Nullable: true}, false)
+			}
+		case "softDeletes", "softDeletesTz", "softDeletesDatetime":
+			col := arg(0)
+			if col == "" {
+				col = "deleted_at"
+			}
+			typ := "timestamp"
+			if head.Name == "softDeletesTz" {
+				typ = "timestampTz"
+			} else if head.Name == "softDeletesDatetime" {
+				typ = "dateTime"
+			}
+			tbl.setColumn(DBColumn{Name: col, Type: typ, Nullable: true}, false)
+		case "rememberToken":
+			tbl.setColumn(DBColumn{Name: "remember_token", Type: "string(100)", Nullable: true}, false)
+		case "morphs", "nullableMorphs", "uuidMorphs", "nullableUuidMorphs", "ulidMorphs", "nullableUlidMorphs", "numericMorphs", "nullableNumericMorphs":
+			name := arg(0)
+			nullable := strings.HasPrefix(head.Name, "nullable")
+			idType := "bigInteger"
+			unsigned := true
+			switch {
+			case strings.Contains(head.Name, "Uuid"):
+				idType, unsigned = "uuid", false
+			case strings.Contains(head.Name, "Ulid"):
+				idType, unsigned = "ulid", false
+			}
+			tbl.setColumn(DBColumn{Name: name + "_type", Type: "string(255)", Nullable: nullable}, false)
+			tbl.setColumn(DBColumn{Name: name + "_id", Type: idType, Unsigned: unsigned, Nullable: nullable}, false)
+			ixCols := []string{name + "_type", name + "_id"}
+			ixName := arg(1)
+			if ixName == "" {
+				ixName = laravelIndexName(tbl.Name, ixCols, "index")
+			}
+			tbl.addIndex(DBIndex{Name: ixName, Columns: ixCols})
+		case "id", "foreignIdFor":
+			name := arg(0)
+			typ := "bigInteger"
+			if head.Name == "foreignIdFor" {
+				name = arg(1)
+				if name == "" {
+					name = snakeCase(phpBaseName(arg(0))) + "_id"
+				}
+			} else if name == "" {
+				name = "id"
+			}
+			col := DBColumn{Name: name, Type: typ, Unsigned: true}
+			if head.Name == "id" {
+				col.AutoIncrement = true
+				col.Primary = true
+			}
+			o.column(tbl, col, calls[1:], pluralize(snakeCase(phpBaseName(arg(0)))))
+			if head.Name == "id" {
+				tbl.addIndex(DBIndex{Name: "primary", Columns: []string{name}, Primary: true})
+			}
+		default:
+			base, ok := laravelColumnTypes[head.Name]
+			if !ok {
+				continue
+			}
+			col := DBColumn{Name: arg(0), Type: base}
+			switch head.Name {
+			case "string", "char":
+				n := arg(1)
+				if n == "" || n == "null" {
+					n = "255"
+				}
+				col.Type = base + "(" + n + ")"
+			case "decimal", "unsignedDecimal", "double", "float":
+				if p := arg(1); p != "" && p != "null" {
+					if sc := arg(2); sc != "" && sc != "null" && head.Name != "float" {
+						col.Type = base + "(" + p + "," + sc + ")"
+					} else {
+						col.Type = base + "(" + p + ")"
+					}
+				}
+			case "enum", "set":
+				col.Type = base + "(" + strings.Join(cols(1), ",") + ")"
+			}
+			if strings.HasPrefix(head.Name, "unsigned") || head.Name == "foreignId" {
+				col.Unsigned = true
+			}
+			if strings.HasSuffix(head.Name, "ncrements") {
+				col.Unsigned, col.AutoIncrement, col.Primary = true, true, true
+			}
+			// integer('x', true) -> autoIncrement
+			if strings.HasSuffix(head.Name, "nteger") && arg(1) == "true" {
+				col.AutoIncrement, col.Primary = true, true
+			}
+			refTable := ""
+			if strings.HasPrefix(head.Name, "foreign") {
+				refTable = pluralize(strings.TrimSuffix(col.Name, "_id"))
+			}
+			o.column(tbl, col, calls[1:], refTable)
+			if col.Primary {
+				tbl.addIndex(DBIndex{Name: "primary", Columns: []string{col.Name}, Primary: true})
+			}
+		}
+	}
+	return true
+}
+
+// column menerapkan modifier chain (nullable, default, unique, constrained, ...) lalu
+// menyimpan kolom ke tabel. refTable dipakai oleh constrained() tanpa argumen.
+func (o *phpOutline) column(tbl *DBTable, col DBColumn, mods []phpChainCall, refTable string) {
+	change := false
+	var fk *DBForeignKey
+	var idx []DBIndex
+	for _, m := range mods {
+		arg := func(i int) string {
+			if i < len(m.Args) {
+				return phpString(m.Args[i])
+			}
+			return ""
+		}
+		switch m.Name {
+		case "nullable":
+			col.Nullable = arg(0) != "false"
+		case "default":
+			col.Default = arg(0)
+		case "useCurrent":
+			col.Default = "CURRENT_TIMESTAMP"
+		case "unsigned":
+			col.Unsigned = true
+		case "autoIncrement":
+			col.AutoIncrement = true
+		case "change":
+			change = true
+		case "primary":
+			col.Primary = true
+			idx = append(idx, DBIndex{Name: "primary", Columns: []string{col.Name}, Primary: true})
+		case "unique":
+			name := arg(0)
+			if name == "" || name == "true" {
+				name = laravelIndexName(tbl.Name, []string{col.Name}, "unique")
+			}
+			if name != "false" {
+				col.Unique = true
+				idx = append(idx, DBIndex{Name: name, Columns: []string{col.Name}, Unique: true})
+			}
+		case "index", "fullText", "fulltext", "spatialIndex":
+			name := arg(0)
+			kind := strings.ToLower(m.Name)
+			if name == "" || name == "true" {
+				name = laravelIndexName(tbl.Name, []string{col.Name}, kind)
+			}
+			ix := DBIndex{Name: name, Columns: []string{col.Name}}
+			switch m.Name {
+			case "fullText", "fulltext":
+				ix.Kind = "fulltext"
+			case "spatialIndex":
+				ix.Kind = "spatial"
+			}
+			idx = append(idx, ix)
+		case "constrained":
+			fk = &DBForeignKey{Columns: []string{col.Name}, RefTable: refTable, RefColumns: []string{"id"}}
+			if t := arg(0); t != "" && t != "null" {
+				fk.RefTable = t
+			}
+			if c := arg(1); c != "" {
+				fk.RefColumns = []string{c}
+			}
+			if n := arg(2); n != "" {
+				fk.Name = n
+			}
+		case "references":
+			if fk == nil {
+				fk = &DBForeignKey{Columns: []string{col.Name}}
+			}
+			fk.RefColumns = phpArgStrings(m.Args)
+		}
+	}
+	if fk != nil {
+		applyForeignModifiers(fk, mods)
+		if fk.Name == "" {
+			fk.Name = laravelIndexName(tbl.Name, fk.Columns, "foreign")
+		}
+		tbl.ForeignKeys = append(tbl.ForeignKeys, *fk)
+	}
+	tbl.setColumn(col, change)
+	for _, ix := range idx {
+		tbl.addIndex(ix)
+	}
+}
+
+// setColumn menambah kolom baru atau mengganti definisi kolom yang ada (->change()).
+func (t *DBTable) setColumn(col DBColumn, change bool) {
+	if t.hasColumn(col.Name) {
+		c := t.column(col.Name)
+		if change {
+			col.Primary = col.Primary || c.Primary
+			col.Unique = col.Unique || c.Unique
+		}
+		*c = col
+		return
+	}
+	t.Columns = append(t.Columns, col)
+}
+
+func applyForeignModifiers(fk *DBForeignKey, mods []phpChainCall) {
+	for _, m := range mods {
+		arg := ""
+		if len(m.Args) > 0 {
+			arg = phpString(m.Args[0])
+		}
+		switch m.Name {
+		case "references":
+			fk.RefColumns = phpArgStrings(m.Args)
+		case "on":
+			fk.RefTable = arg
+		case "onDelete":
+			fk.OnDelete = strings.ToLower(arg)
+		case "onUpdate":
+			fk.OnUpdate = strings.ToLower(arg)
+		case "cascadeOnDelete":
+			fk.OnDelete = "cascade"
+		case "restrictOnDelete":
+			fk.OnDelete = "restrict"
+		case "nullOnDelete":
+			fk.OnDelete = "set null"
+		case "noActionOnDelete":
+			fk.OnDelete = "no action"
+		case "cascadeOnUpdate":
+			fk.OnUpdate = "cascade"
+		case "restrictOnUpdate":
+			fk.OnUpdate = "restrict"
+		case "nullOnUpdate":
+			fk.OnUpdate = "set null"
+		case "noActionOnUpdate":
+			fk.OnUpdate = "no action"
+		}
+	}
+}
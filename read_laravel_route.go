@@ -0,0 +1,368 @@
+package main
+
+import (
+	"maps"
+	"os"
+	"strings"
+)
+
+// parseFormRequest membaca Form Request beserta array rules().
+func parseFormRequest(full string) PHPClassFile {
+	o := readPHPOutline(full)
+	if o == nil {
+		return PHPClassFile{}
+	}
+	pc := o.file
+	if v, ok := o.returnValue("rules"); ok {
+		pc.Rules = phpRules(v)
+	}
+	return pc
+}
+
+// parseController membaca controller dan rules validasi inline per method
+// ($request->validate, $this->validate, Validator::make).
+func parseController(full string) PHPClassFile {
+	o := readPHPOutline(full)
+	if o == nil {
+		return PHPClassFile{}
+	}
+	pc := o.file
+	for i := range pc.Methods {
+		r, ok := o.bodies[pc.Methods[i].Name]
+		if !ok {
+			continue
+		}
+		pc.Methods[i].Rules = o.inlineRules(r)
+	}
+	o.file = pc
+	return pc
+}
+
+func (o *phpOutline) inlineRules(r [2]int) map[string][]string {
+	toks := o.toks
+	var out map[string][]string
+	for k := r[0]; k+1 <= r[1]; k++ {
+		t := toks[k]
+		if t.kind != 'n' || toks[k+1].val != "(" || k == 0 {
+			continue
+		}
+		prev := toks[k-1].val
+		ok := (prev == "->" && (t.val == "validate" || t.val == "validateWithBag")) ||
+			(prev == "::" && t.val == "make" && k >= 2 && strings.HasSuffix(toks[k-2].val, "Validator"))
+		if !ok {
+			continue
+		}
+		close := o.skipBalanced(k+1, "(", ")")
+		for _, part := range o.splitComma(k+2, close-1) {
+			if v, isArr := o.value(part[0], part[1]).(phpArray); isArr {
+				for f, rules := range phpRules(v) {
+					if out == nil {
+						out = map[string][]string{}
+					}
+					out[f] = rules
+				}
+				break
+			}
+		}
+		k = close
+	}
+	return out
+}
+
+// phpRules mengubah array rules Laravel menjadi field -> daftar rule.
+func phpRules(v any) map[string][]string {
+	arr, ok := v.(phpArray)
+	if !ok {
+		return nil
+	}
+	out := map[string][]string{}
+	for _, e := range arr {
+		if !e.HasKey {
+			continue
+		}
+		switch x := e.Val.(type) {
+		case string:
+			for _, r := range strings.Split(x, "|") {
+				if r = strings.TrimSpace(r); r != "" {
+					out[e.Key] = append(out[e.Key], r)
+				}
+			}
+		case phpArray:
+			for _, item := range x {
+				if s, ok := item.Val.(string); ok {
+					for _, r := range strings.Split(s, "|") {
+						out[e.Key] = append(out[e.Key], strings.TrimSpace(r))
+					}
+					continue
+				}
+				out[e.Key] = append(out[e.Key], phpString(item.Val))
+			}
+		default:
+			out[e.Key] = append(out[e.Key], phpString(x))
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+// ================= Laravel route endpoints =================
+
+var laravelVerbs = map[string][]string{
+	"get": {"GET"}, "post": {"POST"}, "put": {"PUT"}, "patch": {"PATCH"}, "delete": {"DELETE"},
+	"options": {"OPTIONS"}, "any": {"ANY"},
+}
+
+type laravelRouteCtx struct {
+	prefix     string
+	controller string
+	name       string
+}
+
+// laravelEndpoints membaca file routes Laravel menjadi endpoint lengkap dengan controller@action.
+func laravelEndpoints(full, rel string) []RouteEndpoint {
+	b, err := os.ReadFile(full)
+	if err != nil || !strings.Contains(string(b), "Route::") {
+		return nil
+	}
+	o := outlinePHP(b)
+	ctx := laravelRouteCtx{}
+	if rel == "routes/api.php" {
+		ctx.prefix = "/api"
+	}
+	return o.laravelRoutes(0, len(o.toks)-1, ctx)
+}
+
+func (o *phpOutline) laravelRoutes(a, b int, ctx laravelRouteCtx) []RouteEndpoint {
+	toks := o.toks
+	var out []RouteEndpoint
+	for k := a; k+1 <= b; k++ {
+		t := toks[k]
+		if t.kind != 'n' || (t.val != "Route" && !strings.HasSuffix(t.val, `\Route`)) || toks[k+1].val != "::" {
+			continue
+		}
+		calls, next := o.chain(k+1, b)
+		k = next - 1
+		cur := ctx
+		var eps []RouteEndpoint
+		for _, c := range calls {
+			arg := func(i int) string {
+				if i < len(c.Args) {
+					return phpString(c.Args[i])
+				}
+				return ""
+			}
+			switch c.Name {
+			case "prefix":
+				cur.prefix = joinPath(cur.prefix, arg(0))
+			case "controller":
+				cur.controller = arg(0)
+			case "name", "as":
+				if len(eps) > 0 {
+					for i := range eps {
+						eps[i].Name = cur.name + arg(0)
+					}
+				} else {
+					cur.name += arg(0)
+				}
+			case "group":
+				sub := cur
+				if len(c.Args) > 0 {
+					if attrs, ok := c.Args[0].(phpArray); ok {
+						for _, e := range attrs {
+							switch e.Key {
+							case "prefix":
+								sub.prefix = joinPath(sub.prefix, phpString(e.Val))
+							case "controller":
+								sub.controller = phpString(e.Val)
+							case "as":
+								sub.name += phpString(e.Val)
+							}
+						}
+					}
+				}
+				if body, _, ok := o.closureBody(c.args); ok {
+					out = append(out, o.laravelRoutes(body[0], body[1], sub)...)
+				}
+			case "match":
+				for _, v := range phpArgStrings(c.Args[:min(1, len(c.Args))]) {
+					eps = append(eps, cur.endpoint(strings.ToUpper(v), arg(1), c.Args[min(2, len(c.Args)):]))
+				}
+			case "resource", "apiResource":
+				eps = append(eps, cur.resource(arg(0), arg(1), c.Name == "apiResource")...)
+			case "only", "except":
+				keep := map[string]bool{}
+				for _, a := range phpArgStrings(c.Args) {
+					keep[a] = true
+				}
+				var filtered []RouteEndpoint
+				for _, ep := range eps {
+					if keep[ep.Action] == (c.Name == "only") {
+						filtered = append(filtered, ep)
+					}
+				}
+				eps = filtered
+			default:
+				if verbs, ok := laravelVerbs[c.Name]; ok && len(c.Args) > 0 {
+					for _, v := range verbs {
+						eps = append(eps, cur.endpoint(v, arg(0), c.Args[1:]))
+					}
+				}
+			}
+		}
+		out = append(out, eps...)
+	}
+	return out
+}
+
+func (ctx laravelRouteCtx) endpoint(method, path string, action []any) RouteEndpoint {
+	ep := RouteEndpoint{Method: method, Path: joinPath(ctx.prefix, path)}
+	if len(action) == 0 {
+		return ep
+	}
+	switch a := action[0].(type) {
+	case phpArray:
+		// [Controller::class, 'method']
+		if len(a) > 0 {
+			ep.Controller = phpString(a[0].Val)
+		}
+		if len(a) > 1 {
+			ep.Action = phpString(a[1].Val)
+		}
+	case phpClassRef:
+		ep.Controller = string(a)
+		ep.Action = "__invoke"
+	case string:
+		if c, m, ok := strings.Cut(a, "@"); ok {
+			ep.Controller, ep.Action = c, m
+			if !strings.Contains(c, `\`) {
+				ep.Controller = `App\Http\Controllers\` + c
+			}
+		} else if ctx.controller != "" {
+			ep.Controller, ep.Action = ctx.controller, a
+		} else {
+			ep.Controller, ep.Action = a, "__invoke"
+		}
+	default:
+		ep.Action = "closure"
+	}
+	return ep
+}
+
+// resource menghasilkan endpoint standar Route::resource / Route::apiResource.
+func (ctx laravelRouteCtx) resource(name, controller string, api bool) []RouteEndpoint {
+	base := strings.Trim(name, "/")
+	param := base
+	if i := strings.LastIndexAny(param, "/."); i >= 0 {
+		param = param[i+1:]
+	}
+	param = "{" + singularize(strings.ReplaceAll(param, "-", "_")) + "}"
+	base = strings.ReplaceAll(base, ".", "/")
+	routeName := ctx.name + strings.ReplaceAll(name, "/", ".")
+	type act struct{ verb, path, action string }
+	acts := []act{
+		{"GET", base, "index"},
+		{"GET", base + "/create", "create"},
+		{"POST", base, "store"},
+		{"GET", base + "/" + param, "show"},
+		{"GET", base + "/" + param + "/edit", "edit"},
+		{"PUT", base + "/" + param, "update"},
+		{"PATCH", base + "/" + param, "update"},
+		{"DELETE", base + "/" + param, "destroy"},
+	}
+	var out []RouteEndpoint
+	for _, a := range acts {
+		if api && (a.action == "create" || a.action == "edit") {
+			continue
+		}
+		out = append(out, RouteEndpoint{
+			Method:     a.verb,
+			Path:       joinPath(ctx.prefix, a.path),
+			Name:       routeName + "." + a.action,
+			Controller: controller,
+			Action:     a.action,
+		})
+	}
+	return out
+}
+
+// singularize kebalikan sederhana dari pluralize (untuk parameter resource).
+func singularize(s string) string {
+	head, last := "", s
+	if i := strings.LastIndexByte(s, '_'); i >= 0 {
+		head, last = s[:i+1], s[i+1:]
+	}
+	for one, many := range irregularPlurals {
+		if last == many {
+			return head + one
+		}
+	}
+	switch {
+	case uncountables[last]:
+	case strings.HasSuffix(last, "ies") && len(last) > 3:
+		last = last[:len(last)-3] + "y"
+	case strings.HasSuffix(last, "ses") || strings.HasSuffix(last, "xes") || strings.HasSuffix(last, "zes") ||
+		strings.HasSuffix(last, "ches") || strings.HasSuffix(last, "shes"):
+		last = last[:len(last)-2]
+	case strings.HasSuffix(last, "s") && !strings.HasSuffix(last, "ss"):
+		last = last[:len(last)-1]
+	}
+	return head + last
+}
+
+// linkLaravelEndpoints menghubungkan endpoint -> method controller -> Form Request & rules.
+func linkLaravelEndpoints(routes []RouteFile, l *LaravelCtx) {
+	requests := map[string]PHPClassFile{}
+	for _, r := range l.Requests {
+		requests[phpFQCN(r)] = r
+	}
+	controllers := map[string]*PHPClassFile{}
+	for i := range l.Controllers {
+		c := &l.Controllers[i]
+		for j := range c.Methods {
+			m := &c.Methods[j]
+			for _, p := range m.Params {
+				fq := c.resolvePHPName(strings.TrimPrefix(p.Type, "?"))
+				if _, ok := requests[fq]; ok {
+					m.Request = fq
+					break
+				}
+			}
+		}
+		controllers[phpFQCN(*c)] = c
+	}
+	for i := range routes {
+		for j := range routes[i].Endpoints {
+			ep := &routes[i].Endpoints[j]
+			c, ok := controllers[strings.TrimPrefix(ep.Controller, `\`)]
+			if !ok {
+				continue
+			}
+			for _, m := range c.Methods {
+				if m.Name != ep.Action {
+					continue
+				}
+				ep.Request = m.Request
+				if m.Request != "" {
+					ep.Rules = maps.Clone(requests[m.Request].Rules)
+				}
+				if len(m.Rules) > 0 {
+					if ep.Rules == nil {
+						ep.Rules = map[string][]string{}
+					}
+					for f, r := range m.Rules {
+						ep.Rules[f] = r
+					}
+				}
+			}
+		}
+	}
+}
+
+func phpFQCN(pc PHPClassFile) string {
+	if pc.Namespace == "" {
+		return pc.Class
+	}
+	return pc.Namespace + `\` + pc.Class
+}
@@ -0,0 +1,522 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// readLockfiles menempelkan versi resolved dari lockfile ke section ekosistem masing-masing.
+func readLockfiles(root string, m *Manifest) {
+	if m.Node != nil {
+		m.Node.Lock = readNodeLock(root, m.Node)
+	}
+	if m.Composer != nil {
+		m.Composer.Lock = readComposerLock(root, m.Composer)
+	}
+	if m.Go != nil {
+		m.Go.Lock = readGoSum(root, m.Go)
+	}
+	if m.Rust != nil {
+		m.Rust.Lock = readCargoLock(root, m.Rust)
+	}
+	if m.Python != nil {
+		m.Python.Lock = readPythonLock(root, m.Python)
+	}
+	if m.Dart != nil {
+		m.Dart.Lock = readPubspecLock(root)
+	}
+	// project Xcode tanpa Package.swift tetap punya Package.resolved
+	if lock := readPackageResolved(root, m.Swift); lock != nil {
+		if m.Swift == nil {
+			m.Swift = &SwiftInfo{}
+		}
+		m.Swift.Lock = lock
+	}
+}
+
+// newLock mengurutkan dan membuang duplikat name+version.
+func newLock(file string, deps []LockedDep) *LockInfo {
+	if len(deps) == 0 {
+		return nil
+	}
+	slices.SortFunc(deps, func(a, b LockedDep) int {
+		return cmp.Or(strings.Compare(a.Name, b.Name), strings.Compare(a.Version, b.Version))
+	})
+	out := deps[:1]
+	for _, d := range deps[1:] {
+		last := &out[len(out)-1]
+		if d.Name == last.Name && d.Version == last.Version {
+			last.Direct = last.Direct || d.Direct
+			last.Dev = last.Dev && d.Dev
+			continue
+		}
+		out = append(out, d)
+	}
+	return &LockInfo{File: file, Packages: out}
+}
+
+// ================= Node =================
+
+func readNodeLock(root string, n *NodeInfo) *LockInfo {
+	direct := map[string]bool{}
+	dev := map[string]bool{}
+	for k := range n.Dependencies {
+		direct[k] = true
+	}
+	for k := range n.DevDependencies {
+		direct[k], dev[k] = true, true
+	}
+	for _, name := range []string{"package-lock.json", "npm-shrinkwrap.json"} {
+		if b, err := os.ReadFile(filepath.Join(root, name)); err == nil {
+			return newLock(name, parseNpmLock(b, direct))
+		}
+	}
+	if b, err := os.ReadFile(filepath.Join(root, "yarn.lock")); err == nil {
+		return newLock("yarn.lock", parseYarnLock(b, direct, dev))
+	}
+	if b, err := os.ReadFile(filepath.Join(root, "pnpm-lock.yaml")); err == nil {
+		return newLock("pnpm-lock.yaml", parsePnpmLock(b, direct, dev))
+	}
+	return nil
+}
+
+type npmLockDep struct {
+	Version      string                `json:"version"`
+	Dev          bool                  `json:"dev"`
+	Link         bool                  `json:"link"`
+	Dependencies map[string]npmLockDep `json:"dependencies"`
+}
+
+// parseNpmLock: lockfileVersion 2/3 memakai "packages", v1 "dependencies" bersarang.
+func parseNpmLock(b []byte, direct map[string]bool) []LockedDep {
+	var lock struct {
+		Packages map[string]struct {
+			Version              string            `json:"version"`
+			Dev                  bool              `json:"dev"`
+			Link                 bool              `json:"link"`
+			Dependencies         map[string]string `json:"dependencies"`
+			DevDependencies      map[string]string `json:"devDependencies"`
+			OptionalDependencies map[string]string `json:"optionalDependencies"`
+		} `json:"packages"`
+		Dependencies map[string]npmLockDep `json:"dependencies"`
+	}
+	if json.Unmarshal(b, &lock) != nil {
+		return nil
+	}
+	var out []LockedDep
+	if len(lock.Packages) > 0 {
+		if r, ok := lock.Packages[""]; ok {
+			direct = map[string]bool{}
+			for _, deps := range []map[string]string{r.Dependencies, r.DevDependencies, r.OptionalDependencies} {
+				for k := range deps {
+					direct[k] = true
+				}
+			}
+		}
+		for key, p := range lock.Packages {
+			i := strings.LastIndex(key, "node_modules/")
+			if i < 0 || p.Link || p.Version == "" {
+				continue
+			}
+			name := key[i+len("node_modules/"):]
+			out = append(out, LockedDep{Name: name, Version: p.Version, Direct: i == 0 && direct[name], Dev: p.Dev})
+		}
+		return out
+	}
+	var walk func(deps map[string]npmLockDep, top bool)
+	walk = func(deps map[string]npmLockDep, top bool) {
+		for name, d := range deps {
+			out = append(out, LockedDep{Name: name, Version: d.Version, Direct: top && direct[name], Dev: d.Dev})
+			walk(d.Dependencies, false)
+		}
+	}
+	walk(lock.Dependencies, true)
+	return out
+}
+
+// parseYarnLock membaca format v1 (version "x") dan berry (version: x).
+func parseYarnLock(b []byte, direct, dev map[string]bool) []LockedDep {
+	var out []LockedDep
+	name := ""
+	sc := bufio.NewScanner(bytes.NewReader(b))
+	sc.Buffer(make([]byte, 0, 64*1024), 1<<20)
+	for sc.Scan() {
+		line := sc.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if line[0] != ' ' {
+			name = ""
+			spec := strings.Trim(strings.TrimSuffix(line, ":"), `"`)
+			spec, _, _ = strings.Cut(spec, ",")
+			spec = strings.Trim(strings.TrimSpace(spec), `"`)
+			if spec == "__metadata" || strings.Contains(spec, "@workspace:") || strings.Contains(spec, "@link:") {
+				continue
+			}
+			if i := strings.LastIndexByte(spec, '@'); i > 0 {
+				name = spec[:i]
+				// berry: "pkg@npm:^1" -> pkg
+				if j := strings.Index(name, "@npm:"); j > 0 {
+					name = name[:j]
+				}
+			}
+			continue
+		}
+		t := strings.TrimSpace(line)
+		if name != "" && strings.HasPrefix(t, "version") {
+			v := strings.Trim(strings.TrimSpace(strings.TrimPrefix(strings.TrimPrefix(t, "version"), ":")), `"`)
+			out = append(out, LockedDep{Name: name, Version: v, Direct: direct[name], Dev: dev[name]})
+			name = ""
+		}
+	}
+	return out
+}
+
+// parsePnpmLock: direct dari importers "." (v6+) atau dependencies top-level (v5),
+// versi dari key section packages ("/name/1.0.0", "/name@1.0.0(peer)", "name@1.0.0").
+func parsePnpmLock(b []byte, direct, dev map[string]bool) []LockedDep {
+	docs, _ := parseYAML(string(b))
+	if len(docs) == 0 {
+		return nil
+	}
+	doc, _ := docs[0].(map[string]any)
+	deps := doc
+	if root, ok := nested(doc, "importers", ".").(map[string]any); ok {
+		deps = root
+	}
+	pnpmDirect := map[string]bool{}
+	for _, kind := range []string{"dependencies", "devDependencies", "optionalDependencies"} {
+		m, _ := deps[kind].(map[string]any)
+		for name := range m {
+			pnpmDirect[name] = true
+			if kind == "devDependencies" {
+				dev[name] = true
+			}
+		}
+	}
+	if len(pnpmDirect) > 0 {
+		direct = pnpmDirect
+	}
+	v5 := strings.HasPrefix(yamlStr(doc["lockfileVersion"]), "5")
+	pkgs, _ := doc["packages"].(map[string]any)
+	out := make([]LockedDep, 0, len(pkgs))
+	for key, v := range pkgs {
+		id := strings.TrimPrefix(key, "/")
+		var name, version string
+		if v5 {
+			// v5: "/name/1.0.0" atau "/@scope/name/1.0.0_peer@2"
+			segs := strings.SplitN(id, "/", 3)
+			name = segs[0]
+			if strings.HasPrefix(id, "@") && len(segs) > 1 {
+				name += "/" + segs[1]
+			}
+			if len(id) > len(name)+1 {
+				version, _, _ = strings.Cut(id[len(name)+1:], "_")
+			}
+		} else {
+			if i := strings.IndexByte(id, '('); i > 0 {
+				id = id[:i]
+			}
+			if i := strings.LastIndexByte(id, '@'); i > 0 {
+				name, version = id[:i], id[i+1:]
+			}
+		}
+		if name == "" {
+			continue
+		}
+		info, _ := v.(map[string]any)
+		out = append(out, LockedDep{Name: name, Version: version, Direct: direct[name], Dev: info["dev"] == true || direct[name] && dev[name]})
+	}
+	return out
+}
+
+// ================= PHP =================
+
+func readComposerLock(root string, c *ComposerInfo) *LockInfo {
+	b, err := os.ReadFile(filepath.Join(root, "composer.lock"))
+	if err != nil {
+		return nil
+	}
+	var lock struct {
+		Packages []struct {
+			Name    string `json:"name"`
+			Version string `json:"version"`
+		} `json:"packages"`
+		PackagesDev []struct {
+			Name    string `json:"name"`
+			Version string `json:"version"`
+		} `json:"packages-dev"`
+	}
+	if json.Unmarshal(b, &lock) != nil {
+		return nil
+	}
+	var out []LockedDep
+	for _, p := range lock.Packages {
+		out = append(out, LockedDep{Name: p.Name, Version: p.Version, Direct: hasAnyKey(c.Require, p.Name)})
+	}
+	for _, p := range lock.PackagesDev {
+		out = append(out, LockedDep{Name: p.Name, Version: p.Version, Direct: hasAnyKey(c.RequireDev, p.Name), Dev: true})
+	}
+	return newLock("composer.lock", out)
+}
+
+// ================= Go =================
+
+// readGoSum: versi terpilih dari require go.mod (// indirect = transitif),
+// sisanya versi tertinggi yang tercatat di go.sum.
+func readGoSum(root string, g *GoInfo) *LockInfo {
+	b, err := os.ReadFile(filepath.Join(root, "go.sum"))
+	if err != nil {
+		return nil
+	}
+	reqs := map[string]GoRequire{}
+	for _, r := range g.Require {
+		reqs[r.Path] = r
+	}
+	sums := map[string]string{}
+	for _, line := range strings.Split(string(b), "\n") {
+		f := strings.Fields(line)
+		if len(f) < 3 || strings.HasSuffix(f[1], "/go.mod") {
+			continue
+		}
+		if cur, ok := sums[f[0]]; !ok || cmpSemver(f[1], cur) > 0 {
+			sums[f[0]] = f[1]
+		}
+	}
+	var out []LockedDep
+	for mod, v := range sums {
+		d := LockedDep{Name: mod, Version: v}
+		if r, ok := reqs[mod]; ok {
+			d.Version, d.Direct = r.Version, !r.Indirect
+		}
+		out = append(out, d)
+	}
+	return newLock("go.sum", out)
+}
+
+// cmpSemver membandingkan vMAJOR.MINOR.PATCH[-pre]; pre-release lebih rendah dari rilis.
+func cmpSemver(a, b string) int {
+	split := func(v string) ([]int, string) {
+		v = strings.TrimSuffix(strings.TrimPrefix(v, "v"), "+incompatible")
+		core, pre, _ := strings.Cut(v, "-")
+		var nums []int
+		for _, p := range strings.Split(core, ".") {
+			n, _ := strconv.Atoi(p)
+			nums = append(nums, n)
+		}
+		return nums, pre
+	}
+	an, ap := split(a)
+	bn, bp := split(b)
+	if c := slices.Compare(an, bn); c != 0 {
+		return c
+	}
+	switch {
+	case ap == bp:
+		return 0
+	case ap == "":
+		return 1
+	case bp == "":
+		return -1
+	}
+	return strings.Compare(ap, bp)
+}
+
+// ================= Rust / Python =================
+
+// tomlPackages mengambil tabel [[package]] (Cargo.lock, poetry.lock).
+func tomlPackages(full string) []map[string]any {
+	var out []map[string]any
+	arr, _ := readTOML(full)["package"].([]any)
+	for _, p := range arr {
+		if m, ok := p.(map[string]any); ok {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+func readCargoLock(root string, r *RustInfo) *LockInfo {
+	var out []LockedDep
+	for _, p := range tomlPackages(filepath.Join(root, "Cargo.lock")) {
+		// crate workspace/path tidak punya source
+		if p["source"] == nil {
+			continue
+		}
+		name := toStr(p["name"])
+		out = append(out, LockedDep{Name: name, Version: toStr(p["version"]), Direct: hasAnyKey(r.Deps, name) || hasAnyKey(r.DevDeps, name) || hasAnyKey(r.BuildDeps, name), Dev: hasAnyKey(r.DevDeps, name) && !hasAnyKey(r.Deps, name)})
+	}
+	return newLock("Cargo.lock", out)
+}
+
+// pyNormName: normalisasi nama distribusi PEP 503.
+func pyNormName(s string) string {
+	return strings.NewReplacer("_", "-", ".", "-").Replace(strings.ToLower(strings.TrimSpace(s)))
+}
+
+// pyDirectDeps mengumpulkan dependency yang dideklarasikan (requirements, pyproject, Pipfile).
+func pyDirectDeps(py *PythonInfo) (direct, dev map[string]bool) {
+	direct, dev = map[string]bool{}, map[string]bool{}
+	for k := range py.Requirements {
+		direct[pyNormName(k)] = true
+	}
+	for _, reqs := range py.RequirementFiles {
+		for k := range reqs {
+			direct[pyNormName(k)] = true
+		}
+	}
+	if py.Conda != nil {
+		for k := range py.Conda.Pip {
+			direct[pyNormName(k)] = true
+		}
+	}
+	for k := range py.Dependencies {
+		direct[pyNormName(k)] = true
+	}
+	for _, reqs := range py.OptionalDependencies {
+		for _, req := range reqs {
+			name, _ := pep508(req)
+			direct[pyNormName(name)] = true
+		}
+	}
+	for _, deps := range py.DependencyGroups {
+		for k := range deps {
+			n := pyNormName(k)
+			dev[n] = dev[n] || !direct[n]
+			direct[n] = true
+		}
+	}
+	return direct, dev
+}
+
+func readPythonLock(root string, py *PythonInfo) *LockInfo {
+	direct, dev := pyDirectDeps(py)
+	if exists(filepath.Join(root, "poetry.lock")) {
+		var out []LockedDep
+		for _, p := range tomlPackages(filepath.Join(root, "poetry.lock")) {
+			n := pyNormName(toStr(p["name"]))
+			out = append(out, LockedDep{Name: toStr(p["name"]), Version: toStr(p["version"]), Direct: direct[n], Dev: p["category"] == "dev" || direct[n] && dev[n]})
+		}
+		return newLock("poetry.lock", out)
+	}
+	// uv.lock / pdm.lock: [[package]] dengan name & version; project sendiri (editable/virtual) dilewati
+	for _, file := range []string{"uv.lock", "pdm.lock"} {
+		if !exists(filepath.Join(root, file)) {
+			continue
+		}
+		var out []LockedDep
+		for _, p := range tomlPackages(filepath.Join(root, file)) {
+			if src, ok := p["source"].(map[string]any); ok && (src["editable"] == "." || src["virtual"] == ".") {
+				continue
+			}
+			n := pyNormName(toStr(p["name"]))
+			out = append(out, LockedDep{Name: toStr(p["name"]), Version: toStr(p["version"]), Direct: direct[n], Dev: direct[n] && dev[n]})
+		}
+		return newLock(file, out)
+	}
+	if b, err := os.ReadFile(filepath.Join(root, "Pipfile.lock")); err == nil {
+		var lock map[string]map[string]struct {
+			Version string `json:"version"`
+		}
+		_ = json.Unmarshal(b, &lock)
+		var out []LockedDep
+		for _, sec := range []string{"default", "develop"} {
+			for name, p := range lock[sec] {
+				n := pyNormName(name)
+				out = append(out, LockedDep{Name: name, Version: strings.TrimPrefix(p.Version, "=="), Direct: direct[n], Dev: sec == "develop"})
+			}
+		}
+		return newLock("Pipfile.lock", out)
+	}
+	return nil
+}
+
+// ================= Dart =================
+
+func readPubspecLock(root string) *LockInfo {
+	doc := readYAML(filepath.Join(root, "pubspec.lock"))
+	pkgs, ok := doc["packages"].(map[string]any)
+	if !ok {
+		return nil
+	}
+	var out []LockedDep
+	for name, raw := range pkgs {
+		p, _ := raw.(map[string]any)
+		// dependency: "direct main", "direct dev", "direct overridden", "transitive"
+		dep := yamlStr(p["dependency"])
+		out = append(out, LockedDep{
+			Name:    name,
+			Version: yamlStr(p["version"]),
+			Direct:  strings.HasPrefix(dep, "direct"),
+			Dev:     dep == "direct dev",
+		})
+	}
+	return newLock("pubspec.lock", out)
+}
+
+// ================= Swift =================
+
+// readPackageResolved mencari Package.resolved SwiftPM (root, .swiftpm, atau project Xcode).
+func readPackageResolved(root string, si *SwiftInfo) *LockInfo {
+	cands := []string{"Package.resolved", ".swiftpm/xcode/package.xcworkspace/xcshareddata/swiftpm/Package.resolved"}
+	for _, g := range []string{"*.xcworkspace/xcshareddata/swiftpm/Package.resolved", "*.xcodeproj/project.xcworkspace/xcshareddata/swiftpm/Package.resolved"} {
+		ms, _ := filepath.Glob(filepath.Join(root, g))
+		for _, m := range ms {
+			rel, _ := filepath.Rel(root, m)
+			cands = append(cands, filepath.ToSlash(rel))
+		}
+	}
+	normURL := func(u string) string {
+		return strings.TrimSuffix(strings.TrimSuffix(strings.ToLower(u), "/"), ".git")
+	}
+	direct := map[string]bool{}
+	if si != nil {
+		for u := range si.Deps {
+			direct[normURL(u)] = true
+		}
+	}
+	type pin struct {
+		Package  string `json:"package"`
+		Identity string `json:"identity"`
+		URL      string `json:"repositoryURL"`
+		Location string `json:"location"`
+		State    struct {
+			Version  string `json:"version"`
+			Branch   string `json:"branch"`
+			Revision string `json:"revision"`
+		} `json:"state"`
+	}
+	for _, rel := range cands {
+		b, err := os.ReadFile(filepath.Join(root, filepath.FromSlash(rel)))
+		if err != nil {
+			continue
+		}
+		var res struct {
+			Pins   []pin `json:"pins"` // v2/v3
+			Object struct {
+				Pins []pin `json:"pins"` // v1
+			} `json:"object"`
+		}
+		if json.Unmarshal(b, &res) != nil {
+			continue
+		}
+		var out []LockedDep
+		for _, p := range append(res.Pins, res.Object.Pins...) {
+			name := cmp.Or(p.Identity, p.Package)
+			ver := cmp.Or(p.State.Version, p.State.Branch)
+			if ver == "" && len(p.State.Revision) >= 12 {
+				ver = p.State.Revision[:12]
+			}
+			out = append(out, LockedDep{Name: name, Version: ver, Direct: direct[normURL(cmp.Or(p.Location, p.URL))]})
+		}
+		return newLock(rel, out)
+	}
+	return nil
+}
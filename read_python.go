@@ -2,54 +2,473 @@ package main
 
 import (
 	"bufio"
-	"bytes"
 	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
 )
 
+var rePyReqName = regexp.MustCompile(`^\s*([A-Za-z0-9][A-Za-z0-9._-]*)`)
+
 func readPython(root string) *PythonInfo {
 	out := &PythonInfo{}
-	if exists(filepath.Join(root, "requirements.txt")) {
-		out.HasPip = true
-		out.Requirements = parseRequirements(filepath.Join(root, "requirements.txt"))
-	}
+	readRequirementFiles(root, out)
 	if exists(filepath.Join(root, "pyproject.toml")) {
-		out.HasPoetry = true
-		out.PyProject = parseTomlLight(filepath.Join(root, "pyproject.toml"), 64*1024)
+		out.PyProject = readTOML(filepath.Join(root, "pyproject.toml"))
+		pyProjectDeps(out, out.PyProject)
+		out.BuildBackend = pyBuildBackend(out.PyProject)
+		out.HasPoetry = nested(out.PyProject, "tool", "poetry") != nil
+		out.HasUV = nested(out.PyProject, "tool", "uv") != nil
 	}
 	if exists(filepath.Join(root, "Pipfile")) {
 		out.HasPipenv = true
+		pip := readTOML(filepath.Join(root, "Pipfile"))
+		pyAddDeps(out, "", pip["packages"])
+		pyAddDeps(out, "dev", pip["dev-packages"])
+		if v := toStr(nested(pip, "requires", "python_version")); v != "" && out.RequiresPython == "" {
+			out.RequiresPython = v
+		}
+	}
+	cfg := readSetupCfg(root, out)
+	if py := readSetupPy(root, out); (cfg || py) && out.BuildBackend == "" {
+		out.BuildBackend = "setuptools"
 	}
-	if !out.HasPip && !out.HasPoetry && !out.HasPipenv {
+	out.Conda = readCondaEnv(root)
+	if out.Conda != nil {
+		out.HasConda = true
+		if v := out.Conda.Dependencies["python"]; v != "" && out.RequiresPython == "" {
+			out.RequiresPython = v
+		}
+	}
+	out.HasPoetry = out.HasPoetry || out.BuildBackend == "poetry" || exists(filepath.Join(root, "poetry.lock"))
+	out.HasUV = out.HasUV || exists(filepath.Join(root, "uv.lock"))
+	if b, err := os.ReadFile(filepath.Join(root, ".python-version")); err == nil {
+		out.PythonVersion = strings.TrimSpace(strings.SplitN(string(b), "\n", 2)[0])
+	}
+	if !out.HasPip && !out.HasPipenv && !out.HasConda && out.PyProject == nil && out.BuildBackend == "" {
 		return nil
 	}
 	return out
 }
 
+// pyBuildBackend memetakan [build-system].build-backend ke nama tool.
+func pyBuildBackend(t map[string]any) string {
+	backend := toStr(nested(t, "build-system", "build-backend"))
+	switch {
+	case strings.HasPrefix(backend, "poetry."):
+		return "poetry"
+	case strings.HasPrefix(backend, "hatchling"):
+		return "hatch"
+	case strings.HasPrefix(backend, "setuptools"):
+		return "setuptools"
+	case strings.HasPrefix(backend, "pdm."):
+		return "pdm"
+	case strings.HasPrefix(backend, "flit"):
+		return "flit"
+	case strings.HasPrefix(backend, "maturin"):
+		return "maturin"
+	case strings.HasPrefix(backend, "uv_build"):
+		return "uv"
+	case backend != "":
+		return backend
+	}
+	// tanpa build-backend: pip memakai setuptools legacy
+	switch {
+	case nested(t, "tool", "poetry") != nil:
+		return "poetry"
+	case nested(t, "build-system") != nil:
+		return "setuptools"
+	}
+	return ""
+}
+
+// pyProjectDeps membaca dependency PEP 621 ([project]), PEP 735 ([dependency-groups]) dan Poetry.
+func pyProjectDeps(out *PythonInfo, t map[string]any) {
+	out.RequiresPython = toStr(nested(t, "project", "requires-python"))
+	for _, req := range toStrings(nested(t, "project", "dependencies")) {
+		name, spec := pep508(req)
+		pyAddDep(out, "", name, spec)
+	}
+	if opt, ok := nested(t, "project", "optional-dependencies").(map[string]any); ok {
+		for extra, reqs := range opt {
+			pyAddExtra(out, extra, toStrings(reqs))
+		}
+	}
+	if groups, ok := t["dependency-groups"].(map[string]any); ok {
+		for g, reqs := range groups {
+			// entri {include-group = "..."} dilewati
+			for _, req := range toStrings(reqs) {
+				name, spec := pep508(req)
+				pyAddDep(out, g, name, spec)
+			}
+		}
+	}
+	// PDM/uv: grup dev sebagai list PEP 508
+	if groups, ok := nested(t, "tool", "pdm", "dev-dependencies").(map[string]any); ok {
+		for g, reqs := range groups {
+			for _, req := range toStrings(reqs) {
+				name, spec := pep508(req)
+				pyAddDep(out, g, name, spec)
+			}
+		}
+	}
+	for _, req := range toStrings(nested(t, "tool", "uv", "dev-dependencies")) {
+		name, spec := pep508(req)
+		pyAddDep(out, "dev", name, spec)
+	}
+	poetry, _ := nested(t, "tool", "poetry").(map[string]any)
+	if poetry == nil {
+		return
+	}
+	if deps, ok := poetry["dependencies"].(map[string]any); ok {
+		if py := poetrySpec(deps["python"]); py != "" && out.RequiresPython == "" {
+			out.RequiresPython = py
+		}
+		for name, v := range deps {
+			if name != "python" {
+				pyAddDep(out, "", name, poetrySpec(v))
+			}
+		}
+	}
+	pyAddDeps(out, "dev", poetry["dev-dependencies"])
+	if groups, ok := poetry["group"].(map[string]any); ok {
+		for g, v := range groups {
+			if group, ok := v.(map[string]any); ok {
+				pyAddDeps(out, g, group["dependencies"])
+			}
+		}
+	}
+	if extras, ok := poetry["extras"].(map[string]any); ok {
+		for extra, names := range extras {
+			pyAddExtra(out, extra, toStrings(names))
+		}
+	}
+}
+
+// pyAddDeps menambah tabel nama -> constraint (Poetry/Pipfile) ke grup ("" = main).
+func pyAddDeps(out *PythonInfo, group string, deps any) {
+	m, _ := deps.(map[string]any)
+	for name, v := range m {
+		pyAddDep(out, group, name, poetrySpec(v))
+	}
+}
+
+func pyAddDep(out *PythonInfo, group, name, spec string) {
+	if name == "" {
+		return
+	}
+	if group == "" || group == "main" {
+		if out.Dependencies == nil {
+			out.Dependencies = map[string]string{}
+		}
+		out.Dependencies[name] = spec
+		return
+	}
+	if out.DependencyGroups == nil {
+		out.DependencyGroups = map[string]map[string]string{}
+	}
+	if out.DependencyGroups[group] == nil {
+		out.DependencyGroups[group] = map[string]string{}
+	}
+	out.DependencyGroups[group][name] = spec
+}
+
+func pyAddExtra(out *PythonInfo, extra string, reqs []string) {
+	if out.OptionalDependencies == nil {
+		out.OptionalDependencies = map[string][]string{}
+	}
+	out.OptionalDependencies[extra] = append(out.OptionalDependencies[extra], reqs...)
+}
+
+// poetrySpec: "^1.0", {version = "^1", extras = [...]}, {git = "..."}, {path = "..."} atau list constraint.
+func poetrySpec(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case map[string]any:
+		switch {
+		case v["version"] != nil:
+			return toStr(v["version"])
+		case v["git"] != nil:
+			return "git:" + toStr(v["git"])
+		case v["path"] != nil:
+			return "path:" + toStr(v["path"])
+		case v["url"] != nil:
+			return "url:" + toStr(v["url"])
+		}
+	case []any:
+		var specs []string
+		for _, x := range v {
+			if s := poetrySpec(x); s != "" {
+				specs = append(specs, s)
+			}
+		}
+		return strings.Join(specs, " || ")
+	}
+	return "*"
+}
+
+// pep508 memecah "name[extra]>=1.0; marker" menjadi nama dan sisanya.
+func pep508(req string) (name, spec string) {
+	req = strings.TrimSpace(req)
+	mm := rePyReqName.FindStringSubmatch(req)
+	if mm == nil {
+		return "", ""
+	}
+	rest := strings.TrimSpace(req[len(mm[0]):])
+	if strings.HasPrefix(rest, "[") {
+		if i := strings.IndexByte(rest, ']'); i >= 0 {
+			rest = strings.TrimSpace(rest[i+1:])
+		}
+	}
+	return mm[1], rest
+}
+
+// readRequirementFiles: requirements.txt ke Requirements, varian lain (requirements-dev.txt,
+// requirements/*.txt) ke RequirementFiles per path.
+func readRequirementFiles(root string, out *PythonInfo) {
+	var files []string
+	for _, pat := range []string{"requirements*.txt", "requirements/*.txt"} {
+		m, _ := filepath.Glob(filepath.Join(root, pat))
+		files = append(files, m...)
+	}
+	for _, full := range files {
+		rel, _ := filepath.Rel(root, full)
+		rel = filepath.ToSlash(rel)
+		reqs := parseRequirements(full)
+		out.HasPip = true
+		if rel == "requirements.txt" {
+			out.Requirements = reqs
+			continue
+		}
+		if out.RequirementFiles == nil {
+			out.RequirementFiles = map[string]map[string]string{}
+		}
+		out.RequirementFiles[rel] = reqs
+	}
+}
+
+var rePyReqVersion = regexp.MustCompile(`^(?:===|==|>=|<=|~=|!=|>|<)\s*([A-Za-z0-9_\-\.*+]+)`)
+
+// parseRequirements membaca nama -> versi; include -r/--requirement diikuti secara rekursif.
 func parseRequirements(full string) map[string]string {
+	m := map[string]string{}
+	if !parseRequirementsInto(full, m, map[string]bool{}) {
+		return nil
+	}
+	return m
+}
+
+func parseRequirementsInto(full string, m map[string]string, seen map[string]bool) bool {
+	if seen[full] {
+		return true
+	}
+	seen[full] = true
 	b, err := os.ReadFile(full)
 	if err != nil {
-		return nil
+		return false
 	}
-	m := map[string]string{}
-	sc := bufio.NewScanner(bytes.NewReader(b))
-	re := regexp.MustCompile(`^\s*([A-Za-z0-9_\-\.]+)\s*(?:==|>=|<=|~=|!=)\s*([A-Za-z0-9_\-\.]+)`)
+	// baris lanjutan "\"
+	src := strings.ReplaceAll(string(b), "\\\n", " ")
+	sc := bufio.NewScanner(strings.NewReader(src))
 	for sc.Scan() {
 		line := strings.TrimSpace(sc.Text())
+		if i := strings.Index(line, " #"); i >= 0 {
+			line = strings.TrimSpace(line[:i])
+		}
 		if line == "" || strings.HasPrefix(line, "#") {
 			continue
 		}
-		pkg := line
+		if strings.HasPrefix(line, "-") {
+			opt, arg, _ := strings.Cut(line, " ")
+			if strings.HasPrefix(opt, "--requirement=") {
+				opt, arg = "-r", strings.TrimPrefix(opt, "--requirement=")
+			}
+			if opt == "-r" || opt == "--requirement" {
+				parseRequirementsInto(filepath.Join(filepath.Dir(full), strings.TrimSpace(arg)), m, seen)
+			}
+			// -c constraint, -e editable, --index-url, dst. dilewati
+			continue
+		}
+		name, spec := pep508(line)
+		if name == "" {
+			continue
+		}
 		ver := ""
-		if mm := re.FindStringSubmatch(line); len(mm) == 3 {
-			pkg = mm[1]
-			ver = mm[2]
+		if mm := rePyReqVersion.FindStringSubmatch(spec); mm != nil {
+			ver = mm[1]
 		}
-		if pkg != "" {
-			m[pkg] = ver
+		m[name] = ver
+	}
+	return true
+}
+
+// readSetupCfg membaca [options] install_requires/python_requires dan extras_require.
+func readSetupCfg(root string, out *PythonInfo) bool {
+	ini := readINI(filepath.Join(root, "setup.cfg"))
+	if ini == nil {
+		return false
+	}
+	opts := ini["options"]
+	for _, req := range iniList(opts["install_requires"]) {
+		name, spec := pep508(req)
+		pyAddDep(out, "", name, spec)
+	}
+	if v := opts["python_requires"]; v != "" && out.RequiresPython == "" {
+		out.RequiresPython = v
+	}
+	for extra, v := range ini["options.extras_require"] {
+		pyAddExtra(out, extra, iniList(v))
+	}
+	return opts != nil || ini["metadata"] != nil
+}
+
+// readSetupPy mengevaluasi argumen setup(...) secara literal; variabel level modul ikut di-resolve.
+func readSetupPy(root string, out *PythonInfo) bool {
+	lines := readPyLines(filepath.Join(root, "setup.py"))
+	if lines == nil {
+		return false
+	}
+	vars := map[string]any{}
+	for _, a := range pyAssigns(lines, 0, len(lines)) {
+		vars[a.Key] = a.Val
+	}
+	resolve := func(v any) any {
+		if r, ok := v.(pyRaw); ok && vars[string(r)] != nil {
+			return vars[string(r)]
 		}
+		return v
 	}
-	return m
+	for _, l := range lines {
+		c, ok := pyValue(l.Text).(pyCall)
+		if !ok || (c.Name != "setup" && !strings.HasSuffix(c.Name, ".setup")) {
+			continue
+		}
+		if v, ok := c.kwarg("install_requires"); ok {
+			for _, req := range pyStrings(resolve(v)) {
+				name, spec := pep508(req)
+				pyAddDep(out, "", name, spec)
+			}
+		}
+		if v, ok := c.kwarg("python_requires"); ok {
+			if s, ok := resolve(v).(string); ok && out.RequiresPython == "" {
+				out.RequiresPython = s
+			}
+		}
+		if v, ok := c.kwarg("extras_require"); ok {
+			if d, ok := resolve(v).(pyDict); ok {
+				for _, e := range d {
+					pyAddExtra(out, e.Key, pyStrings(resolve(e.Val)))
+				}
+			}
+		}
+		break
+	}
+	return true
+}
+
+// readCondaEnv membaca environment.yml (dependency conda + blok pip).
+func readCondaEnv(root string) *CondaEnv {
+	file := firstExist(root, []string{"environment.yml", "environment.yaml"})
+	if file == "" {
+		return nil
+	}
+	doc := readYAML(filepath.Join(root, file))
+	if doc == nil {
+		return nil
+	}
+	env := &CondaEnv{File: file, Name: yamlStr(doc["name"])}
+	env.Channels = toStrings(doc["channels"])
+	deps, _ := doc["dependencies"].([]any)
+	for _, d := range deps {
+		if m, ok := d.(map[string]any); ok {
+			for _, req := range toStrings(m["pip"]) {
+				name, spec := pep508(req)
+				if name == "" {
+					continue
+				}
+				if env.Pip == nil {
+					env.Pip = map[string]string{}
+				}
+				env.Pip[name] = spec
+			}
+			continue
+		}
+		name, spec := condaSpec(yamlStr(d))
+		if name == "" {
+			continue
+		}
+		if env.Dependencies == nil {
+			env.Dependencies = map[string]string{}
+		}
+		env.Dependencies[name] = spec
+	}
+	return env
+}
+
+// condaSpec: "conda-forge::numpy=1.24", "python>=3.10", "pandas 2.0.*".
+func condaSpec(s string) (name, spec string) {
+	s = strings.TrimSpace(s)
+	if i := strings.Index(s, "::"); i >= 0 {
+		s = s[i+2:]
+	}
+	i := strings.IndexAny(s, "=<>!~ ")
+	if i < 0 {
+		return s, ""
+	}
+	name, spec = s[:i], strings.TrimSpace(s[i:])
+	if strings.HasPrefix(spec, "=") && !strings.HasPrefix(spec, "==") {
+		spec = spec[1:]
+	}
+	return name, spec
+}
+
+// readINI membaca file INI/cfg: section -> key -> value; baris indent melanjutkan value sebelumnya.
+func readINI(full string) map[string]map[string]string {
+	b, err := os.ReadFile(full)
+	if err != nil {
+		return nil
+	}
+	out := map[string]map[string]string{}
+	section, key := "", ""
+	for _, raw := range strings.Split(string(b), "\n") {
+		t := strings.TrimSpace(raw)
+		if t == "" || t[0] == '#' || t[0] == ';' {
+			continue
+		}
+		if raw[0] == ' ' || raw[0] == '\t' {
+			if sec := out[section]; sec != nil && key != "" {
+				sec[key] = strings.TrimLeft(sec[key]+"\n"+t, "\n")
+			}
+			continue
+		}
+		if strings.HasPrefix(t, "[") && strings.HasSuffix(t, "]") {
+			section, key = strings.TrimSpace(t[1:len(t)-1]), ""
+			if out[section] == nil {
+				out[section] = map[string]string{}
+			}
+			continue
+		}
+		i := strings.IndexAny(t, "=:")
+		if i <= 0 || out[section] == nil {
+			continue
+		}
+		key = strings.TrimSpace(t[:i])
+		out[section][key] = strings.TrimSpace(t[i+1:])
+	}
+	return out
+}
+
+// iniList memecah value multi-baris (dangling list) setup.cfg; komentar dibuang.
+func iniList(v string) []string {
+	var out []string
+	for _, l := range strings.Split(v, "\n") {
+		if i := strings.Index(l, " #"); i >= 0 {
+			l = l[:i]
+		}
+		if l = strings.TrimSpace(l); l != "" && !strings.HasPrefix(l, "#") {
+			out = append(out, l)
+		}
+	}
+	return out
 }
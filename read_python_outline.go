@@ -0,0 +1,203 @@
+package main
+
+import (
+	"bufio"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var reDjangoMigrationFile = regexp.MustCompile(`/migrations/\d{4}_\w+\.py$`)
+
+// readPythonOutline merangkum class/def top-level setiap module .py plus entry point.
+func readPythonOutline(root string) *PythonOutline {
+	out := &PythonOutline{EntryPoints: readPyEntryPoints(root)}
+	walkFiles(root, func(full, rel string) {
+		if !strings.HasSuffix(rel, ".py") || reDjangoMigrationFile.MatchString("/"+rel) {
+			return
+		}
+		if fi, err := os.Stat(full); err != nil || fi.Size() > 512<<10 {
+			return
+		}
+		if mod, ok := pyModuleOutline(readPyLines(full), rel); ok {
+			out.Modules = append(out.Modules, mod)
+		}
+	})
+	if len(out.Modules) == 0 && len(out.EntryPoints) == 0 {
+		return nil
+	}
+	slices.SortFunc(out.Modules, func(a, b PyModule) int { return strings.Compare(a.Path, b.Path) })
+	return out
+}
+
+func pyModuleOutline(lines []pyLine, rel string) (PyModule, bool) {
+	mod := PyModule{Path: rel, Module: pyModuleName(rel)}
+	if len(lines) == 0 {
+		return mod, false
+	}
+	mod.Doc = pyDocstring(lines, 0, len(lines))
+	for _, l := range lines {
+		if l.Indent == 0 && strings.HasPrefix(l.Text, "if __name__") && strings.Contains(l.Text, "__main__") {
+			mod.Main = true
+		}
+	}
+	for _, d := range pyDefs(lines, 0, len(lines)) {
+		if strings.HasPrefix(d.Name, "_") {
+			continue
+		}
+		switch d.Kind {
+		case "class":
+			c := PyClass{Name: d.Name, Bases: d.Bases, Line: d.Line, Decorators: d.Decorators, Doc: pyDocstring(lines, d.Body[0], d.Body[1])}
+			for _, m := range pyDefs(lines, d.Body[0], d.Body[1]) {
+				if m.Kind == "def" && (!strings.HasPrefix(m.Name, "_") || m.Name == "__init__" || m.Name == "__call__") {
+					c.Methods = append(c.Methods, pyFunc(lines, m))
+				}
+			}
+			mod.Classes = append(mod.Classes, c)
+		case "def":
+			mod.Functions = append(mod.Functions, pyFunc(lines, d))
+		}
+	}
+	return mod, len(mod.Classes)+len(mod.Functions) > 0 || mod.Main
+}
+
+func pyFunc(lines []pyLine, d pyDef) PyFunc {
+	f := PyFunc{Name: d.Name, Line: d.Line, Decorators: d.Decorators, Doc: pyDocstring(lines, d.Body[0], d.Body[1])}
+	f.Signature = "(" + strings.Join(d.Bases, ", ") + ")"
+	if d.Returns != "" {
+		f.Signature += " -> " + d.Returns
+	}
+	if d.Body[0] > 0 {
+		f.Async = strings.HasPrefix(lines[d.Body[0]-1].Text, "async ")
+	}
+	return f
+}
+
+// pyDocstring mengambil baris pertama docstring di awal blok lines[a:b].
+func pyDocstring(lines []pyLine, a, b int) string {
+	if a >= b {
+		return ""
+	}
+	s, ok := pyStringLit(lines[a].Text)
+	if !ok {
+		return ""
+	}
+	s = strings.TrimSpace(s)
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		s = s[:i]
+	}
+	return truncate(strings.TrimSpace(s), 200)
+}
+
+// pyModuleName: src/pkg/mod.py -> pkg.mod, pkg/__init__.py -> pkg.
+func pyModuleName(rel string) string {
+	rel = strings.TrimPrefix(strings.TrimSuffix(rel, ".py"), "src/")
+	rel = strings.TrimSuffix(strings.TrimSuffix(rel, "__init__"), "/")
+	return strings.ReplaceAll(rel, "/", ".")
+}
+
+// ================= entry points =================
+
+// readPyEntryPoints membaca [project.scripts], [project.entry-points.*],
+// [tool.poetry.scripts], setup.cfg [options.entry_points] dan setup(entry_points=...).
+func readPyEntryPoints(root string) []PyEntryPoint {
+	var out []PyEntryPoint
+	if exists(filepath.Join(root, "pyproject.toml")) {
+		toml := readTOML(filepath.Join(root, "pyproject.toml"))
+		groups := map[string]any{
+			"console_scripts": nested(toml, "project", "scripts"),
+			"gui_scripts":     nested(toml, "project", "gui-scripts"),
+		}
+		if eps, ok := nested(toml, "project", "entry-points").(map[string]any); ok {
+			for g, tbl := range eps {
+				groups[g] = tbl
+			}
+		}
+		// [tool.poetry.scripts] digabung ke console_scripts
+		if poetry, ok := nested(toml, "tool", "poetry", "scripts").(map[string]any); ok {
+			merged, _ := groups["console_scripts"].(map[string]any)
+			merged = maps.Clone(merged)
+			if merged == nil {
+				merged = map[string]any{}
+			}
+			maps.Copy(merged, poetry)
+			groups["console_scripts"] = merged
+		}
+		for _, group := range slices.Sorted(maps.Keys(groups)) {
+			tbl, _ := groups[group].(map[string]any)
+			for _, k := range slices.Sorted(maps.Keys(tbl)) {
+				out = append(out, PyEntryPoint{Name: k, Target: pyEntryTarget(tbl[k]), Group: group, Source: "pyproject.toml"})
+			}
+		}
+	}
+	if f, err := os.Open(filepath.Join(root, "setup.cfg")); err == nil {
+		sc := bufio.NewScanner(f)
+		section, group := "", ""
+		for sc.Scan() {
+			raw := sc.Text()
+			line := strings.TrimSpace(raw)
+			if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+				continue
+			}
+			if strings.HasPrefix(line, "[") {
+				section = strings.Trim(line, "[]")
+				continue
+			}
+			if section != "options.entry_points" {
+				continue
+			}
+			indented := raw[0] == ' ' || raw[0] == '\t'
+			k, v, ok := strings.Cut(line, "=")
+			switch {
+			case !indented && ok:
+				group = strings.TrimSpace(k)
+				if v = strings.TrimSpace(v); v == "" {
+					continue
+				}
+				k, v, ok = strings.Cut(v, "=")
+				if !ok {
+					continue
+				}
+				fallthrough
+			case indented && ok:
+				out = append(out, PyEntryPoint{Name: strings.TrimSpace(k), Target: strings.TrimSpace(v), Group: group, Source: "setup.cfg"})
+			}
+		}
+		f.Close()
+	}
+	for _, l := range readPyLines(filepath.Join(root, "setup.py")) {
+		i := strings.Index(l.Text, "setup(")
+		if i < 0 {
+			continue
+		}
+		call, ok := pyValue(l.Text[i:]).(pyCall)
+		if !ok {
+			continue
+		}
+		ep, _ := call.kwarg("entry_points")
+		eps, _ := ep.(pyDict)
+		for _, g := range eps {
+			specs := pyStrings(g.Val)
+			if s, ok := g.Val.(string); ok {
+				specs = strings.Split(s, "\n")
+			}
+			for _, spec := range specs {
+				if k, v, ok := strings.Cut(spec, "="); ok {
+					out = append(out, PyEntryPoint{Name: strings.TrimSpace(k), Target: strings.TrimSpace(v), Group: g.Key, Source: "setup.py"})
+				}
+			}
+		}
+	}
+	return out
+}
+
+// pyEntryTarget: "pkg.cli:main" atau inline table poetry {callable = "..."}.
+func pyEntryTarget(v any) string {
+	if m, ok := v.(map[string]any); ok {
+		return toStr(m["callable"])
+	}
+	return toStr(v)
+}
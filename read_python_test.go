@@ -0,0 +1,44 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPyProjectDeps(t *testing.T) {
+	cases := []struct {
+		name string
+		src  string
+		want string // JSON PythonInfo tanpa pyproject
+	}{
+		{
+			name: "pep 621",
+			src:  "[project]\nrequires-python = \"~=3.10\"\ndependencies = [\"requests==2.31\", \"click\"]\n[project.optional-dependencies]\ncli = [\"rich\"]\n[dependency-groups]\ntest = [\"pytest~=8.0\", {include-group = \"x\"}]\n",
+			want: `{"has_pip":false,"has_poetry":false,"has_pipenv":false,"requires_python":"~=3.10","dependencies":{"click":"","requests":"==2.31"},"optional_dependencies":{"cli":["rich"]},"dependency_groups":{"test":{"pytest":"~=8.0"}}}`,
+		},
+		{
+			name: "poetry groups",
+			src:  "[tool.poetry.dependencies]\npython = \"^3.11\"\ndjango = \"^5.0\"\n[tool.poetry.group.dev.dependencies]\npytest = { version = \"^8\" }\n",
+			want: `{"has_pip":false,"has_poetry":false,"has_pipenv":false,"requires_python":"^3.11","dependencies":{"django":"^5.0"},"dependency_groups":{"dev":{"pytest":"^8"}}}`,
+		},
+		{
+			name: "poetry group that is not a table",
+			src:  "[tool.poetry.group]\ndev = \"oops\"\n",
+			want: `{"has_pip":false,"has_poetry":false,"has_pipenv":false}`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			doc, err := parseTOML(tc.src)
+			if err != nil {
+				t.Fatalf("parseTOML: %v", err)
+			}
+			var out PythonInfo
+			pyProjectDeps(&out, doc)
+			got, _ := json.Marshal(out)
+			if string(got) != tc.want {
+				t.Errorf("got  %s\nwant %s", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,450 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+type rbLine struct {
+	Line int
+	Text string
+}
+
+var (
+	reRbScope      = regexp.MustCompile(`^(class|module)\s+([A-Z][\w:]*)(?:\s*<\s*([\w:]+(?:\[[^\]]*\])?))?`)
+	reRbDef        = regexp.MustCompile(`^def\s+(self\.)?([\w]+[?!=]?)`)
+	reRbOpenKw     = regexp.MustCompile(`^(class|module|def|if|unless|while|until|case|begin|for)\b`)
+	reRbOpenAssign = regexp.MustCompile(`(=|\|\||&&|return)\s*(if|unless|case|begin|while)\b`)
+	reRbDo         = regexp.MustCompile(`\bdo\s*(\|[^|]*\|)?\s*$`)
+	reRbEnd        = regexp.MustCompile(`(^|;)\s*end\b`)
+	reRbEndlessDef = regexp.MustCompile(`^def\s+[\w.]+[?!]?(\([^)]*\))?\s*=[^=~]`)
+	reRbDSL        = regexp.MustCompile(`^([a-z_]+)\b\s*\(?\s*(.*?)\)?$`)
+)
+
+// rubyLines membuang komentar dan blok =begin/=end, serta menggabungkan baris lanjutan.
+func rubyLines(src string) []rbLine {
+	var out []rbLine
+	var cur *rbLine
+	inDoc := false
+	for i, raw := range strings.Split(src, "\n") {
+		line := strings.TrimSpace(raw)
+		if inDoc {
+			inDoc = !strings.HasPrefix(line, "=end")
+			continue
+		}
+		if strings.HasPrefix(line, "=begin") {
+			inDoc = true
+			continue
+		}
+		line = strings.TrimSpace(stripRubyComment(line))
+		if line == "" {
+			continue
+		}
+		if cur != nil {
+			cur.Text += " " + line
+		} else {
+			out = append(out, rbLine{Line: i + 1, Text: line})
+			cur = &out[len(out)-1]
+		}
+		// lanjut ke baris berikut jika diakhiri koma/operator atau kurung belum tertutup
+		t := cur.Text
+		if strings.HasSuffix(t, ",") || strings.HasSuffix(t, "\\") || strings.HasSuffix(t, "(") || strings.HasSuffix(t, "[") ||
+			strings.Count(t, "(")+strings.Count(t, "[") > strings.Count(t, ")")+strings.Count(t, "]") {
+			cur.Text = strings.TrimSuffix(t, "\\")
+			continue
+		}
+		cur = nil
+	}
+	return out
+}
+
+func stripRubyComment(s string) string {
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if quote != 0 {
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+			continue
+		}
+		switch c {
+		case '"', '\'':
+			quote = c
+		case '#':
+			if i == 0 || s[i-1] == ' ' || s[i-1] == '\t' {
+				return s[:i]
+			}
+		}
+	}
+	return s
+}
+
+// rubyBlockDelta menghitung perubahan kedalaman blok (class/def/do/if ... end) untuk satu baris.
+func rubyBlockDelta(t string) int {
+	opens := 0
+	switch {
+	case reRbEndlessDef.MatchString(t):
+	case strings.HasPrefix(t, "class << "):
+		opens++
+	case reRbOpenKw.MatchString(t):
+		opens++
+	case reRbOpenAssign.MatchString(t):
+		opens++
+	}
+	if reRbDo.MatchString(t) {
+		opens++
+	}
+	return opens - len(reRbEnd.FindAllString(t, -1))
+}
+
+// rubyArgs memecah argumen DSL: simbol/string positional dan opsi key: value.
+func rubyArgs(s string) ([]string, map[string]string) {
+	var pos []string
+	var opts map[string]string
+	for _, p := range splitTopLevel(s, ',') {
+		k, v, ok := rubyKeyValue(p)
+		if ok {
+			if opts == nil {
+				opts = map[string]string{}
+			}
+			opts[k] = rubyScalar(v)
+			continue
+		}
+		pos = append(pos, rubyScalar(p))
+	}
+	return pos, opts
+}
+
+func rubyKeyValue(p string) (string, string, bool) {
+	if i := strings.Index(p, "=>"); i > 0 {
+		return rubyScalar(p[:i]), strings.TrimSpace(p[i+2:]), true
+	}
+	// key: value (bukan ::Const dan bukan :symbol)
+	for i := 1; i < len(p); i++ {
+		c := p[i]
+		if c == ':' {
+			if i+1 < len(p) && p[i+1] == ':' {
+				return "", "", false
+			}
+			k := strings.TrimSpace(p[:i])
+			if strings.ContainsAny(k, " \"'(:{[") {
+				return "", "", false
+			}
+			return k, strings.TrimSpace(p[i+1:]), true
+		}
+		if !(c == '_' || c == '?' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')) {
+			return "", "", false
+		}
+	}
+	return "", "", false
+}
+
+func rubyScalar(s string) string {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, ":") && !strings.HasPrefix(s, "::") {
+		return trimQuotes(s[1:])
+	}
+	return trimQuotes(s)
+}
+
+type rbScope struct {
+	rc        *RubyClassFile
+	depth     int // kedalaman body scope
+	private   bool
+	singleton bool // class << self
+}
+
+// parseRubyClass membaca definisi class/module utama di sebuah file Ruby beserta DSL Rails-nya.
+func parseRubyClass(full, rel string) RubyClassFile {
+	b, err := os.ReadFile(full)
+	if err != nil {
+		return RubyClassFile{Path: rel}
+	}
+	var scopes []*rbScope
+	var defs []*RubyClassFile
+	depth := 0
+	for _, l := range rubyLines(string(b)) {
+		t := l.Text
+		var top *rbScope
+		if len(scopes) > 0 {
+			top = scopes[len(scopes)-1]
+		}
+		switch {
+		case strings.HasPrefix(t, "class << self"):
+			scopes = append(scopes, &rbScope{depth: depth + 1, singleton: true})
+		case reRbScope.MatchString(t):
+			m := reRbScope.FindStringSubmatch(t)
+			var parts []string
+			for _, sc := range scopes {
+				if sc.rc != nil {
+					parts = []string{sc.rc.Name}
+				}
+			}
+			name := strings.Join(append(parts, m[2]), "::")
+			rc := &RubyClassFile{Path: rel, Kind: m[1], Name: name, Superclass: m[3], Line: l.Line}
+			defs = append(defs, rc)
+			scopes = append(scopes, &rbScope{rc: rc, depth: depth + 1})
+		case top != nil && top.rc != nil && top.depth == depth:
+			top.statement(t)
+		}
+		depth += rubyBlockDelta(t)
+		for len(scopes) > 0 && scopes[len(scopes)-1].depth > depth {
+			scopes = scopes[:len(scopes)-1]
+		}
+	}
+	// class utama: class pertama (paling luar), selain itu module terdalam (concern)
+	for _, d := range defs {
+		if d.Kind == "class" {
+			return *d
+		}
+	}
+	if len(defs) > 0 {
+		return *defs[len(defs)-1]
+	}
+	return RubyClassFile{Path: rel}
+}
+
+var railsAssociations = map[string]bool{"belongs_to": true, "has_many": true, "has_one": true, "has_and_belongs_to_many": true}
+
+// statement memproses satu statement di body class: def, visibility dan DSL Rails.
+func (s *rbScope) statement(t string) {
+	rc := s.rc
+	switch t {
+	case "private", "protected":
+		s.private = true
+		return
+	case "public":
+		s.private = false
+		return
+	}
+	if m := reRbDef.FindStringSubmatch(t); m != nil {
+		if m[1] == "" && !s.private && !strings.HasSuffix(m[2], "=") && m[2] != "initialize" {
+			rc.Methods = append(rc.Methods, m[2])
+		}
+		return
+	}
+	m := reRbDSL.FindStringSubmatch(t)
+	if m == nil {
+		if strings.HasPrefix(t, "self.table_name =") {
+			rc.Table = trimQuotes(strings.TrimSpace(strings.TrimPrefix(t, "self.table_name =")))
+		}
+		return
+	}
+	kw, rest := m[1], m[2]
+	// buang blok/lambda di akhir DSL: scope :x, -> { ... } / do ... end
+	if i := strings.Index(rest, " do"); i >= 0 && reRbDo.MatchString(rest) {
+		rest = rest[:i]
+	}
+	args, opts := rubyArgs(rest)
+	switch {
+	case railsAssociations[kw] && len(args) > 0:
+		rc.Associations = append(rc.Associations, RailsAssociation{Type: kw, Name: args[0], Options: opts})
+	case kw == "validates" || kw == "validates!":
+		var rules []string
+		for k := range opts {
+			rules = append(rules, k)
+		}
+		slices.Sort(rules)
+		for _, a := range args {
+			rc.Validations = append(rc.Validations, a+": "+strings.Join(rules, ", "))
+		}
+	case strings.HasPrefix(kw, "validates_") && strings.HasSuffix(kw, "_of"):
+		rule := strings.TrimSuffix(strings.TrimPrefix(kw, "validates_"), "_of")
+		for _, a := range args {
+			rc.Validations = append(rc.Validations, a+": "+rule)
+		}
+	case kw == "validate" || kw == "validates_with":
+		rc.Validations = append(rc.Validations, kw+": "+strings.Join(args, ", "))
+	case kw == "scope" && len(args) > 0:
+		rc.Scopes = append(rc.Scopes, args[0])
+	case kw == "enum":
+		if len(args) > 0 && !strings.HasPrefix(args[0], "{") {
+			rc.Enums = append(rc.Enums, args[0])
+		} else {
+			for k := range opts {
+				if !strings.HasPrefix(k, "_") {
+					rc.Enums = append(rc.Enums, k)
+				}
+			}
+			slices.Sort(rc.Enums)
+		}
+	case kw == "include":
+		rc.Includes = append(rc.Includes, args...)
+	case kw == "queue_as" && len(args) > 0:
+		rc.QueueAs = args[0]
+	case strings.HasPrefix(kw, "before_") || strings.HasPrefix(kw, "after_") || strings.HasPrefix(kw, "around_") ||
+		strings.HasPrefix(kw, "skip_before_") || strings.HasPrefix(kw, "skip_after_"):
+		rc.Callbacks = append(rc.Callbacks, truncate(t, 160))
+	}
+}
+
+// readRails menginventaris app/ Rails: controllers, models, jobs, mailers dan concerns.
+func readRails(root string) *RailsInfo {
+	if !exists(filepath.Join(root, "config", "application.rb")) && !exists(filepath.Join(root, "bin", "rails")) {
+		return nil
+	}
+	out := &RailsInfo{}
+	walkFiles(filepath.Join(root, "app"), func(full, rel string) {
+		if !strings.HasSuffix(rel, ".rb") {
+			return
+		}
+		rel = "app/" + rel
+		var dst *[]RubyClassFile
+		switch {
+		case strings.Contains(rel, "/concerns/"):
+			dst = &out.Concerns
+		case strings.HasPrefix(rel, "app/controllers/"):
+			dst = &out.Controllers
+		case strings.HasPrefix(rel, "app/models/"):
+			dst = &out.Models
+		case strings.HasPrefix(rel, "app/jobs/"):
+			dst = &out.Jobs
+		case strings.HasPrefix(rel, "app/mailers/"):
+			dst = &out.Mailers
+		default:
+			return
+		}
+		*dst = append(*dst, parseRubyClass(full, rel))
+	})
+	for _, b := range []*[]RubyClassFile{&out.Controllers, &out.Models, &out.Jobs, &out.Mailers, &out.Concerns} {
+		slices.SortFunc(*b, func(a, b RubyClassFile) int { return strings.Compare(a.Path, b.Path) })
+	}
+	return out
+}
+
+var railsColumnTypes = map[string]bool{
+	"string": true, "text": true, "integer": true, "bigint": true, "float": true, "decimal": true, "numeric": true,
+	"datetime": true, "timestamp": true, "time": true, "date": true, "binary": true, "blob": true, "boolean": true,
+	"json": true, "jsonb": true, "uuid": true, "inet": true, "cidr": true, "macaddr": true, "hstore": true,
+	"citext": true, "interval": true, "money": true, "xml": true, "tsvector": true, "enum": true, "virtual": true,
+}
+
+// readRailsSchema membaca db/schema.rb, atau db/structure.sql jika schema.rb tidak ada.
+func readRailsSchema(root string, withER bool) *DBSchema {
+	var s *DBSchema
+	if b, err := os.ReadFile(filepath.Join(root, "db", "schema.rb")); err == nil {
+		s = parseRailsSchema(string(b))
+		s.Files = []string{"db/schema.rb"}
+	} else if b, err := os.ReadFile(filepath.Join(root, "db", "structure.sql")); err == nil {
+		s = parseSQLSchema(string(b))
+		s.Source = "rails"
+		s.Files = []string{"db/structure.sql"}
+	}
+	if s == nil || len(s.Tables) == 0 {
+		return nil
+	}
+	if withER {
+		s.Mermaid = mermaidER(s)
+	}
+	return s
+}
+
+func parseRailsSchema(src string) *DBSchema {
+	s := &DBSchema{Source: "rails"}
+	var t *DBTable
+	for _, l := range rubyLines(src) {
+		txt := l.Text
+		m := reRbDSL.FindStringSubmatch(txt)
+		if strings.HasPrefix(txt, "t.") {
+			m = reRbDSL.FindStringSubmatch(txt[2:])
+		}
+		if m == nil {
+			if txt == "end" {
+				t = nil
+			}
+			continue
+		}
+		kw, rest := m[1], strings.TrimSpace(reRbDo.ReplaceAllString(m[2], ""))
+		args, opts := rubyArgs(rest)
+		arg := func(i int) string {
+			if i < len(args) {
+				return args[i]
+			}
+			return ""
+		}
+		switch {
+		case kw == "create_table" && !strings.HasPrefix(txt, "t."):
+			t = s.table(arg(0))
+			if opts["id"] != "false" {
+				pk := "id"
+				if opts["primary_key"] != "" {
+					pk = opts["primary_key"]
+				}
+				typ := "bigint"
+				if opts["id"] != "" {
+					typ = opts["id"]
+				}
+				t.Columns = append(t.Columns, DBColumn{Name: pk, Type: typ, Primary: true, AutoIncrement: typ == "bigint" || typ == "integer" || typ == "serial"})
+				t.addIndex(DBIndex{Columns: []string{pk}, Primary: true})
+			}
+		case kw == "add_foreign_key":
+			from := s.table(arg(0))
+			fk := DBForeignKey{RefTable: arg(1), RefColumns: []string{"id"}, Name: opts["name"]}
+			col := opts["column"]
+			if col == "" {
+				col = singularize(arg(1)) + "_id"
+			}
+			fk.Columns = []string{col}
+			if opts["primary_key"] != "" {
+				fk.RefColumns = []string{opts["primary_key"]}
+			}
+			fk.OnDelete = strings.ReplaceAll(opts["on_delete"], "_", " ")
+			fk.OnUpdate = strings.ReplaceAll(opts["on_update"], "_", " ")
+			from.ForeignKeys = append(from.ForeignKeys, fk)
+		case t == nil || !strings.HasPrefix(txt, "t."):
+		case kw == "index":
+			ix := DBIndex{Name: opts["name"], Unique: opts["unique"] == "true"}
+			ix.Columns = rubyStringList(arg(0))
+			t.addIndex(ix)
+		case kw == "timestamps":
+			for _, c := range []string{"created_at", "updated_at"} {
+				t.Columns = append(t.Columns, DBColumn{Name: c, Type: "datetime", Nullable: opts["null"] != "false"})
+			}
+		case kw == "references" || kw == "belongs_to":
+			col := DBColumn{Name: arg(0) + "_id", Type: "bigint", Nullable: opts["null"] != "false"}
+			if opts["type"] != "" {
+				col.Type = opts["type"]
+			}
+			t.Columns = append(t.Columns, col)
+			if opts["polymorphic"] == "true" {
+				t.Columns = append(t.Columns, DBColumn{Name: arg(0) + "_type", Type: "string", Nullable: col.Nullable})
+			}
+		case railsColumnTypes[kw]:
+			for _, name := range args {
+				col := DBColumn{Name: name, Type: kw, Nullable: opts["null"] != "false"}
+				if opts["limit"] != "" {
+					col.Type += "(" + opts["limit"] + ")"
+				} else if opts["precision"] != "" && opts["scale"] != "" {
+					col.Type += "(" + opts["precision"] + "," + opts["scale"] + ")"
+				}
+				if d, ok := opts["default"]; ok {
+					col.Default = d
+				}
+				if opts["array"] == "true" {
+					col.Type += "[]"
+				}
+				t.Columns = append(t.Columns, col)
+			}
+		}
+	}
+	return s
+}
+
+// rubyStringList membaca ["a", "b"] atau "a" menjadi daftar string.
+func rubyStringList(s string) []string {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "[") && strings.HasSuffix(s, "]") {
+		var out []string
+		for _, p := range splitTopLevel(s[1:len(s)-1], ',') {
+			out = append(out, rubyScalar(p))
+		}
+		return out
+	}
+	return []string{rubyScalar(s)}
+}
@@ -1,20 +1,102 @@
 package main
 
-import "path/filepath"
+import (
+	"cmp"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strings"
+)
 
 func readRust(root string) *RustInfo {
 	f := filepath.Join(root, "Cargo.toml")
 	if !exists(f) {
 		return nil
 	}
-	j := parseTomlLight(f, 64*1024)
+	j := readTOML(f)
 	ri := &RustInfo{
 		Package: toStr(nested(j, "package", "name")),
 		Edition: toStr(nested(j, "package", "edition")),
-		Deps:    toStrMap(nested(j, "dependencies")),
 	}
 	if nested(j, "workspace") != nil {
 		ri.Workspace = true
 	}
+	for _, kind := range []string{"normal", "dev", "build"} {
+		ri.Crates = append(ri.Crates, rustDeps(j, kind, "")...)
+	}
+	// dependency khusus platform: [target.'cfg(unix)'.dependencies]
+	targets, _ := j["target"].(map[string]any)
+	for _, t := range slices.Sorted(maps.Keys(targets)) {
+		tbl, _ := targets[t].(map[string]any)
+		for _, kind := range []string{"normal", "dev", "build"} {
+			ri.Crates = append(ri.Crates, rustDeps(tbl, kind, t)...)
+		}
+	}
+	for _, d := range ri.Crates {
+		dst := &ri.Deps
+		switch d.Kind {
+		case "dev":
+			dst = &ri.DevDeps
+		case "build":
+			dst = &ri.BuildDeps
+		}
+		if *dst == nil {
+			*dst = map[string]string{}
+		}
+		(*dst)[d.Name] = rustDepSpec(d)
+	}
+	if feats, ok := j["features"].(map[string]any); ok {
+		ri.Features = map[string][]string{}
+		for k, v := range feats {
+			ri.Features[k] = append([]string{}, toStrings(v)...)
+		}
+	}
 	return ri
 }
+
+// rustDeps membaca tabel [dependencies]/[dev-dependencies]/[build-dependencies] dari tbl.
+func rustDeps(tbl map[string]any, kind, target string) []RustDep {
+	key := map[string]string{"normal": "dependencies", "dev": "dev-dependencies", "build": "build-dependencies"}[kind]
+	deps, _ := tbl[key].(map[string]any)
+	// ejaan lama dev_dependencies/build_dependencies masih diterima cargo
+	if deps == nil {
+		deps, _ = tbl[strings.ReplaceAll(key, "-", "_")].(map[string]any)
+	}
+	var out []RustDep
+	for _, name := range slices.Sorted(maps.Keys(deps)) {
+		d := RustDep{Name: name, Kind: kind, Target: target}
+		switch v := deps[name].(type) {
+		case string:
+			d.Version = v
+		case map[string]any:
+			d.Version = toStr(v["version"])
+			d.Package = toStr(v["package"])
+			d.Path = toStr(v["path"])
+			d.Git = toStr(v["git"])
+			d.Ref = cmp.Or(toStr(v["branch"]), toStr(v["tag"]), toStr(v["rev"]))
+			d.Workspace, _ = v["workspace"].(bool)
+			d.Optional, _ = v["optional"].(bool)
+			if def, ok := v["default-features"].(bool); ok {
+				d.NoDefault = !def
+			}
+			d.Features = toStrings(v["features"])
+		}
+		out = append(out, d)
+	}
+	return out
+}
+
+// rustDepSpec meringkas sumber dependency untuk map Deps: versi, path:, git:, atau workspace.
+func rustDepSpec(d RustDep) string {
+	switch {
+	case d.Version != "":
+		return d.Version
+	case d.Path != "":
+		return "path:" + d.Path
+	case d.Git != "":
+		return "git:" + d.Git
+	case d.Workspace:
+		return "workspace"
+	}
+	return "*"
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"os"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ================= SQL migration files =================
+
+var (
+	reMigrateUp   = regexp.MustCompile(`^(\d+)_.+\.up\.sql$`)        // golang-migrate
+	reFlywayFile  = regexp.MustCompile(`^([VR])([\d._]*)__.+\.sql$`) // Flyway: V1_1__init.sql, R__views.sql
+	reVersionName = regexp.MustCompile(`^(\d+)[_\-.]`)               // goose/dbmate/plain: 20230101_x.sql
+	reGooseStmt   = regexp.MustCompile(`(?im)^\s*--\s*\+goose\s+statement(?:begin|end)\s*$`)
+	reLiquibaseRb = regexp.MustCompile(`(?im)^\s*--\s*rollback\b.*$`)
+)
+
+type sqlMigrationFile struct {
+	Rel     string
+	Tool    string
+	Rank    int   // 0 versioned, 1 repeatable (Flyway R__ diterapkan terakhir)
+	Version []int // segmen angka versi dari nama file
+	SQL     string
+}
+
+// readSQLSchema me-replay file .sql (migration/DDL) sesuai urutan versi menjadi katalog schema.
+func readSQLSchema(root string, withER bool) *DBSchema {
+	var files []sqlMigrationFile
+	walkFiles(root, func(full, rel string) {
+		if !strings.EqualFold(path.Ext(rel), ".sql") || strings.HasSuffix(rel, ".down.sql") {
+			return
+		}
+		if fi, err := os.Stat(full); err != nil || fi.Size() > 2<<20 {
+			return
+		}
+		b, err := os.ReadFile(full)
+		if err != nil {
+			return
+		}
+		if f, ok := sqlMigration(rel, string(b)); ok {
+			files = append(files, f)
+		}
+	})
+	if len(files) == 0 {
+		return nil
+	}
+	// urut per direktori, lalu R__ di belakang, lalu versi, lalu nama
+	slices.SortStableFunc(files, func(a, b sqlMigrationFile) int {
+		if c := strings.Compare(path.Dir(a.Rel), path.Dir(b.Rel)); c != 0 {
+			return c
+		}
+		if a.Rank != b.Rank {
+			return a.Rank - b.Rank
+		}
+		if c := slices.Compare(a.Version, b.Version); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Rel, b.Rel)
+	})
+
+	s := &DBSchema{Source: "sql"}
+	var tools []string
+	for _, f := range files {
+		n := len(s.Tables) + len(s.Views)
+		for _, stmt := range sqlStatements(f.SQL) {
+			applySQL(s, stmt)
+		}
+		if len(s.Tables)+len(s.Views) == n && !reSQLDDL.MatchString(f.SQL) {
+			continue // seed/query file, bukan DDL
+		}
+		s.Files = append(s.Files, f.Rel)
+		if f.Tool != "" && !slices.Contains(tools, f.Tool) {
+			tools = append(tools, f.Tool)
+		}
+	}
+	if len(s.Tables) == 0 && len(s.Views) == 0 {
+		return nil
+	}
+	s.Tool = strings.Join(tools, ", ")
+	if withER {
+		s.Mermaid = mermaidER(s)
+	}
+	return s
+}
+
+var reSQLDDL = regexp.MustCompile(`(?im)^\s*(?:create|alter|drop|rename)\s+(?:table|index|unique|view|materialized|or\s+replace)`)
+
+// sqlMigration mendeteksi tool dari konvensi nama file / penanda di isi, dan
+// mengambil bagian "up" saja.
+func sqlMigration(rel, src string) (sqlMigrationFile, bool) {
+	base := path.Base(rel)
+	f := sqlMigrationFile{Rel: rel, SQL: src}
+	head := strings.ToLower(src[:min(len(src), 4096)])
+	switch {
+	case strings.Contains(head, "-- +goose up"):
+		f.Tool = "goose"
+		f.SQL = sqlSection(src, "-- +goose up", "-- +goose down")
+		f.SQL = reGooseStmt.ReplaceAllString(f.SQL, "")
+	case strings.Contains(head, "-- migrate:up"):
+		f.Tool = "dbmate"
+		f.SQL = sqlSection(src, "-- migrate:up", "-- migrate:down")
+	case strings.HasPrefix(strings.TrimSpace(head), "--liquibase formatted sql"):
+		f.Tool = "liquibase"
+		// buang blok rollback
+		f.SQL = reLiquibaseRb.ReplaceAllString(src, "")
+	case reMigrateUp.MatchString(base):
+		f.Tool = "golang-migrate"
+	case reFlywayFile.MatchString(base):
+		m := reFlywayFile.FindStringSubmatch(base)
+		f.Tool = "flyway"
+		if m[1] == "R" {
+			f.Rank = 1
+		}
+		for _, p := range strings.FieldsFunc(m[2], func(r rune) bool { return r == '.' || r == '_' }) {
+			n, _ := strconv.Atoi(p)
+			f.Version = append(f.Version, n)
+		}
+		return f, true
+	case strings.HasPrefix(base, "U") && strings.Contains(base, "__"):
+		return f, false // Flyway undo
+	}
+	if m := reVersionName.FindStringSubmatch(base); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		f.Version = []int{n}
+	}
+	return f, true
+}
+
+// sqlSection mengambil teks antara penanda up dan down (case-insensitive).
+func sqlSection(src, up, down string) string {
+	low := strings.ToLower(src)
+	i := strings.Index(low, up)
+	if i < 0 {
+		return src
+	}
+	i += len(up)
+	if j := strings.Index(low[i:], down); j >= 0 {
+		return src[i : i+j]
+	}
+	return src[i:]
+}
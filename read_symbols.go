@@ -0,0 +1,98 @@
+package main
+
+import (
+	"cmp"
+	"path"
+	"slices"
+	"strings"
+)
+
+// buildSymbols merangkum definisi dari outliner per bahasa menjadi satu index ala ctags.
+func buildSymbols(m *Manifest) []Symbol {
+	var out []Symbol
+	if m.Go != nil {
+		for _, p := range m.Go.Packages {
+			for _, t := range p.Types {
+				out = append(out, Symbol{Name: t.Name, Kind: t.Kind, File: path.Join(p.Dir, t.File), Line: t.Line, Container: p.ImportPath, Lang: "go"})
+				for _, fn := range t.methods {
+					out = append(out, Symbol{Name: fn.Name, Kind: "method", File: path.Join(p.Dir, fn.File), Line: fn.Line, Container: t.Name, Lang: "go"})
+				}
+			}
+			for _, fn := range p.Funcs {
+				out = append(out, Symbol{Name: fn.Name, Kind: "func", File: path.Join(p.Dir, fn.File), Line: fn.Line, Container: p.ImportPath, Lang: "go"})
+			}
+		}
+	}
+	if m.JSOutline != nil {
+		for _, mod := range m.JSOutline.Modules {
+			lang := "javascript"
+			if strings.Contains(path.Ext(mod.Path), "ts") {
+				lang = "typescript"
+			}
+			for _, ex := range mod.Exports {
+				out = append(out, Symbol{Name: ex.Name, Kind: ex.Kind, File: mod.Path, Line: ex.Line, Lang: lang})
+			}
+			if mod.Default != "" {
+				// export default App -> cari lewat "App"; ekspresi anonim lewat "default"
+				name := mod.Default
+				if !rePyCallee.MatchString(name) {
+					name = "default"
+				}
+				out = append(out, Symbol{Name: name, Kind: "default", File: mod.Path, Line: mod.DefaultLine, Lang: lang})
+			}
+		}
+	}
+	if m.PythonOutline != nil {
+		for _, mod := range m.PythonOutline.Modules {
+			for _, c := range mod.Classes {
+				out = append(out, Symbol{Name: c.Name, Kind: "class", File: mod.Path, Line: c.Line, Container: mod.Module, Lang: "python"})
+				for _, fn := range c.Methods {
+					out = append(out, Symbol{Name: fn.Name, Kind: "method", File: mod.Path, Line: fn.Line, Container: c.Name, Lang: "python"})
+				}
+			}
+			for _, fn := range mod.Functions {
+				out = append(out, Symbol{Name: fn.Name, Kind: "function", File: mod.Path, Line: fn.Line, Container: mod.Module, Lang: "python"})
+			}
+		}
+	}
+	for _, c := range m.JVMOutline {
+		out = append(out, Symbol{Name: c.Name, Kind: c.Kind, File: c.Path, Line: c.Line, Container: c.Package, Lang: c.Lang})
+		for _, f := range c.Fields {
+			out = append(out, Symbol{Name: f.Name, Kind: "field", File: c.Path, Line: f.Line, Container: c.Name, Lang: c.Lang})
+		}
+		for _, fn := range c.Methods {
+			out = append(out, Symbol{Name: fn.Name, Kind: "method", File: c.Path, Line: fn.Line, Container: c.Name, Lang: c.Lang})
+		}
+	}
+	if m.DotNet != nil {
+		for _, t := range m.DotNet.Types {
+			out = append(out, Symbol{Name: t.Name, Kind: t.Kind, File: t.Path, Line: t.Line, Container: t.Namespace, Lang: "csharp"})
+			for _, mem := range t.Members {
+				out = append(out, Symbol{Name: mem.Name, Kind: mem.Kind, File: t.Path, Line: mem.Line, Container: t.Name, Lang: "csharp"})
+			}
+		}
+	}
+	slices.SortFunc(out, func(a, b Symbol) int {
+		return cmp.Or(strings.Compare(a.File, b.File), a.Line-b.Line, strings.Compare(a.Name, b.Name))
+	})
+	return out
+}
+
+// findSymbols mencocokkan nama persis atau "Container.Name"; fallback case-insensitive.
+func findSymbols(syms []Symbol, name string) []Symbol {
+	match := func(eq func(a, b string) bool) []Symbol {
+		var out []Symbol
+		for _, s := range syms {
+			// container boleh ditulis lengkap atau segmen terakhirnya (pkg.Func, Class.method)
+			short := s.Container[strings.LastIndexAny(s.Container, "./")+1:]
+			if eq(s.Name, name) || s.Container != "" && (eq(s.Container+"."+s.Name, name) || eq(short+"."+s.Name, name)) {
+				out = append(out, s)
+			}
+		}
+		return out
+	}
+	if out := match(func(a, b string) bool { return a == b }); len(out) > 0 {
+		return out
+	}
+	return match(strings.EqualFold)
+}
@@ -0,0 +1,397 @@
+package main
+
+import (
+	"cmp"
+	"encoding/json"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var (
+	reGradleInclude  = regexp.MustCompile(`(?m)^\s*include\b\s*\(?([^\n)]*)`)
+	reGradleQuoted   = regexp.MustCompile(`["']([^"']+)["']`)
+	reGradleProjDeps = regexp.MustCompile(`project\(\s*(?:path\s*[:=]\s*)?["']([^"']+)["']`)
+)
+
+// wsBuilder mengumpulkan direktori workspace dari berbagai tool tanpa duplikat.
+type wsBuilder struct {
+	root  string
+	dirs  []string // cache semua direktori (rel) untuk ekspansi glob
+	tools []string
+	byDir map[string]*Workspace
+	order []string
+}
+
+func (b *wsBuilder) tool(name string) {
+	if !slices.Contains(b.tools, name) {
+		b.tools = append(b.tools, name)
+	}
+}
+
+// add mendaftarkan dir (rel) bila berisi marker (kosong = tanpa syarat).
+func (b *wsBuilder) add(rel, source, marker string) {
+	rel = path.Clean(filepath.ToSlash(rel))
+	if rel == "." || strings.HasPrefix(rel, "../") || rel == ".." {
+		return
+	}
+	if marker != "" && !exists(filepath.Join(b.root, filepath.FromSlash(rel), marker)) {
+		return
+	}
+	w := b.byDir[rel]
+	if w == nil {
+		w = &Workspace{Path: rel}
+		b.byDir[rel] = w
+		b.order = append(b.order, rel)
+	}
+	if !slices.Contains(w.Sources, source) {
+		w.Sources = append(w.Sources, source)
+	}
+}
+
+// addGlobs mengekspansi pola workspace ("apps/*", "packages/**", "!packages/legacy").
+func (b *wsBuilder) addGlobs(patterns []string, source, marker string) {
+	var include, exclude []string
+	for _, p := range patterns {
+		p = strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(p), "./"), "/")
+		if neg, ok := strings.CutPrefix(p, "!"); ok {
+			exclude = append(exclude, strings.TrimPrefix(neg, "./"))
+		} else if p != "" {
+			include = append(include, p)
+		}
+	}
+	if b.dirs == nil {
+		filepath.WalkDir(b.root, func(full string, d fs.DirEntry, err error) error {
+			if err != nil || !d.IsDir() {
+				return nil
+			}
+			rel, _ := filepath.Rel(b.root, full)
+			rel = filepath.ToSlash(rel)
+			if rel == "." {
+				return nil
+			}
+			if skipHeavyDir(rel) {
+				return filepath.SkipDir
+			}
+			b.dirs = append(b.dirs, rel)
+			return nil
+		})
+	}
+	for _, dir := range b.dirs {
+		if globAny(include, dir) && !globAny(exclude, dir) {
+			b.add(dir, source, marker)
+		}
+	}
+}
+
+func globAny(patterns []string, rel string) bool {
+	for _, p := range patterns {
+		if globSegs(strings.Split(p, "/"), strings.Split(rel, "/")) {
+			return true
+		}
+	}
+	return false
+}
+
+// globSegs mencocokkan per segmen path; "**" cocok dengan nol atau lebih segmen.
+func globSegs(pat, segs []string) bool {
+	if len(pat) == 0 {
+		return len(segs) == 0
+	}
+	if pat[0] == "**" {
+		for i := 0; i <= len(segs); i++ {
+			if globSegs(pat[1:], segs[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(segs) == 0 {
+		return false
+	}
+	ok, _ := path.Match(pat[0], segs[0])
+	return ok && globSegs(pat[1:], segs[1:])
+}
+
+func readWorkspaces(root string, m *Manifest) *WorkspaceInfo {
+	b := &wsBuilder{root: root, byDir: map[string]*Workspace{}}
+
+	// ================= JS: npm/yarn/pnpm/lerna/nx/turbo =================
+	var pkg rawJSON
+	if raw, err := os.ReadFile(filepath.Join(root, "package.json")); err == nil {
+		_ = json.Unmarshal(raw, &pkg)
+	}
+	// "workspaces": [...] atau {"packages": [...]} (yarn classic)
+	jsGlobs := toStrings(pkg["workspaces"])
+	if ws, ok := pkg["workspaces"].(map[string]any); ok {
+		jsGlobs = toStrings(ws["packages"])
+	}
+	if len(jsGlobs) > 0 {
+		manager := "npm"
+		if exists(filepath.Join(root, "yarn.lock")) || exists(filepath.Join(root, ".yarnrc.yml")) {
+			manager = "yarn"
+		}
+		b.tool(manager)
+		b.addGlobs(jsGlobs, manager, "package.json")
+	}
+	if exists(filepath.Join(root, "pnpm-workspace.yaml")) {
+		pnpm := readYAML(filepath.Join(root, "pnpm-workspace.yaml"))
+		b.tool("pnpm")
+		b.addGlobs(toStrings(pnpm["packages"]), "pnpm", "package.json")
+	}
+	if raw, err := os.ReadFile(filepath.Join(root, "lerna.json")); err == nil {
+		var lerna rawJSON
+		_ = json.Unmarshal(raw, &lerna)
+		globs := toStrings(lerna["packages"])
+		if len(globs) == 0 && len(jsGlobs) == 0 {
+			globs = []string{"packages/*"}
+		}
+		b.tool("lerna")
+		b.addGlobs(globs, "lerna", "package.json")
+	}
+	if exists(filepath.Join(root, "turbo.json")) {
+		b.tool("turborepo")
+	}
+	if exists(filepath.Join(root, "nx.json")) {
+		b.tool("nx")
+		// project Nx ditandai project.json
+		b.addGlobs([]string{"**"}, "nx", "project.json")
+	}
+
+	// ================= go.work =================
+	if m.Go != nil && m.Go.Work != nil {
+		b.tool("go.work")
+		for _, u := range m.Go.Work.Use {
+			b.add(u.Dir, "go.work", "go.mod")
+		}
+	}
+
+	// ================= Cargo workspace =================
+	if exists(filepath.Join(root, "Cargo.toml")) {
+		cargo := readTOML(filepath.Join(root, "Cargo.toml"))
+		if members := toStrings(nested(cargo, "workspace", "members")); len(members) > 0 {
+			b.tool("cargo")
+			globs := members
+			for _, ex := range toStrings(nested(cargo, "workspace", "exclude")) {
+				globs = append(globs, "!"+ex)
+			}
+			b.addGlobs(globs, "cargo", "Cargo.toml")
+		}
+	}
+
+	// ================= Maven modules (rekursif) =================
+	var mavenModules func(dir string)
+	mavenModules = func(dir string) {
+		if !exists(filepath.Join(root, filepath.FromSlash(dir), "pom.xml")) {
+			return
+		}
+		for _, mod := range readMaven(filepath.Join(root, filepath.FromSlash(dir))).Modules {
+			rel := path.Join(dir, strings.TrimSpace(mod))
+			if _, seen := b.byDir[rel]; seen || strings.HasPrefix(rel, "..") {
+				continue
+			}
+			b.tool("maven")
+			b.add(rel, "maven", "pom.xml")
+			mavenModules(rel)
+		}
+	}
+	mavenModules(".")
+
+	// ================= Gradle settings include =================
+	if settings := firstExist(root, []string{"settings.gradle.kts", "settings.gradle"}); settings != "" {
+		raw, _ := os.ReadFile(filepath.Join(root, settings))
+		for _, mm := range reGradleInclude.FindAllSubmatch(raw, -1) {
+			for _, q := range reGradleQuoted.FindAllSubmatch(mm[1], -1) {
+				b.tool("gradle")
+				b.add(strings.ReplaceAll(strings.TrimPrefix(string(q[1]), ":"), ":", "/"), "gradle", "")
+			}
+		}
+	}
+
+	// ================= Composer path repositories =================
+	if raw, err := os.ReadFile(filepath.Join(root, "composer.json")); err == nil {
+		var comp rawJSON
+		_ = json.Unmarshal(raw, &comp)
+		var repos []any
+		switch r := comp["repositories"].(type) {
+		case []any:
+			repos = r
+		case map[string]any:
+			for _, v := range r {
+				repos = append(repos, v)
+			}
+		}
+		var globs []string
+		for _, r := range repos {
+			if rm, ok := r.(map[string]any); ok && rm["type"] == "path" {
+				globs = append(globs, toStr(rm["url"]))
+			}
+		}
+		if len(globs) > 0 {
+			b.tool("composer")
+			b.addGlobs(globs, "composer", "composer.json")
+		}
+	}
+
+	if len(b.order) == 0 {
+		return nil
+	}
+	out := &WorkspaceInfo{Tools: b.tools}
+	slices.Sort(b.order)
+	for _, rel := range b.order {
+		w := b.byDir[rel]
+		readWorkspace(root, w)
+		out.Packages = append(out.Packages, *w)
+	}
+	out.Edges = workspaceEdges(root, out.Packages)
+	return out
+}
+
+// readWorkspace menjalankan detector manifest di direktori workspace.
+func readWorkspace(root string, w *Workspace) {
+	dir := filepath.Join(root, filepath.FromSlash(w.Path))
+	w.Composer = readComposer(dir)
+	w.Node = readPackageJSON(dir)
+	w.Go = readGoModule(dir)
+	w.Python = readPython(dir)
+	w.Rust = readRust(dir)
+	w.Java = readJava(dir)
+	w.Dart = readDart(dir)
+
+	switch {
+	case w.Node != nil && w.Node.Name != "":
+		w.Name = w.Node.Name
+	case w.Composer != nil && w.Composer.Name != "":
+		w.Name = w.Composer.Name
+	case w.Go != nil && w.Go.Module != "":
+		w.Name = w.Go.Module
+	case w.Rust != nil && w.Rust.Package != "":
+		w.Name = w.Rust.Package
+	case w.Dart != nil && w.Dart.Name != "":
+		w.Name = w.Dart.Name
+	case w.Python != nil && toStr(nested(w.Python.PyProject, "project", "name")) != "":
+		w.Name = toStr(nested(w.Python.PyProject, "project", "name"))
+	}
+	if w.Name == "" && w.Java != nil && w.Java.BuildTool == "maven" {
+		w.Name = w.Java.Artifact
+	}
+	if w.Name == "" {
+		if raw, err := os.ReadFile(filepath.Join(dir, "project.json")); err == nil {
+			var nx rawJSON
+			_ = json.Unmarshal(raw, &nx)
+			w.Name = toStr(nx["name"])
+		}
+	}
+	if w.Name == "" && slices.Contains(w.Sources, "gradle") {
+		w.Name = ":" + strings.ReplaceAll(w.Path, "/", ":")
+	}
+	if w.Name == "" {
+		w.Name = path.Base(w.Path)
+	}
+}
+
+// workspaceEdges mencocokkan dependency setiap package dengan nama package workspace lain.
+func workspaceEdges(root string, pkgs []Workspace) []WorkspaceEdge {
+	index := map[string]map[string]string{} // kind -> nama -> path
+	put := func(kind, name, rel string) {
+		if name == "" {
+			return
+		}
+		if index[kind] == nil {
+			index[kind] = map[string]string{}
+		}
+		index[kind][name] = rel
+	}
+	for _, w := range pkgs {
+		if w.Node != nil {
+			put("node", w.Node.Name, w.Path)
+		}
+		if w.Composer != nil {
+			put("composer", w.Composer.Name, w.Path)
+		}
+		if w.Go != nil {
+			put("go", w.Go.Module, w.Path)
+		}
+		if w.Rust != nil {
+			put("cargo", w.Rust.Package, w.Path)
+		}
+		if w.Java != nil && w.Java.BuildTool == "maven" {
+			put("maven", w.Java.GroupID+":"+w.Java.Artifact, w.Path)
+		}
+		if slices.Contains(w.Sources, "gradle") {
+			put("gradle", ":"+strings.ReplaceAll(w.Path, "/", ":"), w.Path)
+		}
+	}
+
+	var out []WorkspaceEdge
+	seen := map[WorkspaceEdge]bool{}
+	link := func(from, kind, name string, dev bool) {
+		to, ok := index[kind][name]
+		if !ok || to == from {
+			return
+		}
+		e := WorkspaceEdge{From: from, To: to, Kind: kind, Dev: dev}
+		if !seen[e] {
+			seen[e] = true
+			out = append(out, e)
+		}
+	}
+	for _, w := range pkgs {
+		if w.Node != nil {
+			for name := range w.Node.Dependencies {
+				link(w.Path, "node", name, false)
+			}
+			for name := range w.Node.DevDependencies {
+				link(w.Path, "node", name, true)
+			}
+		}
+		if w.Composer != nil {
+			for name := range w.Composer.Require {
+				link(w.Path, "composer", name, false)
+			}
+			for name := range w.Composer.RequireDev {
+				link(w.Path, "composer", name, true)
+			}
+		}
+		if w.Go != nil {
+			for _, r := range w.Go.Require {
+				link(w.Path, "go", r.Path, false)
+			}
+		}
+		if w.Rust != nil {
+			for _, d := range w.Rust.Crates {
+				name := cmp.Or(d.Package, d.Name)
+				link(w.Path, "cargo", name, d.Kind == "dev")
+			}
+		}
+		if w.Java != nil && w.Java.BuildTool == "maven" {
+			for _, d := range w.Java.Dependencies {
+				link(w.Path, "maven", d.Group+":"+d.Artifact, d.Scope == "test")
+			}
+		}
+		if slices.Contains(w.Sources, "gradle") {
+			dir := filepath.Join(root, filepath.FromSlash(w.Path))
+			if build := firstExist(dir, []string{"build.gradle.kts", "build.gradle"}); build != "" {
+				raw, _ := os.ReadFile(filepath.Join(dir, build))
+				for _, line := range strings.Split(string(raw), "\n") {
+					dev := strings.HasPrefix(strings.TrimSpace(line), "test")
+					for _, mm := range reGradleProjDeps.FindAllStringSubmatch(line, -1) {
+						name := mm[1]
+						if !strings.HasPrefix(name, ":") {
+							name = ":" + name
+						}
+						link(w.Path, "gradle", name, dev)
+					}
+				}
+			}
+		}
+	}
+	slices.SortFunc(out, func(a, b WorkspaceEdge) int {
+		return cmp.Or(strings.Compare(a.From, b.From), strings.Compare(a.To, b.To), strings.Compare(a.Kind, b.Kind))
+	})
+	return out
+}
@@ -128,9 +128,6 @@ var (
 
 	// Rails
 	reRails = regexp.MustCompile(`\b(get|post|put|patch|delete)\s+['"]([^'"]+)['"]`)
-
-	reYamlPathKey = regexp.MustCompile(`(?m)^\s{0,4}(/[^:\s]+):\s*$`)
-	reYamlVerbKey = regexp.MustCompile(`(?m)^\s{2,6}(get|post|put|patch|delete):\s*$`)
 )
 
 func readRouteFile(full, rel string, routeMaxLines int) RouteFile {
@@ -176,10 +173,13 @@ func readRouteFile(full, rel string, routeMaxLines int) RouteFile {
 		parseOpenAPIJSON(text, &out, guessedSet)
 	}
 	if ext == ".yaml" || ext == ".yml" {
-		parseOpenAPIYAMLHeuristic(text, &out, guessedSet)
+		parseOpenAPIYAML(text, &out, guessedSet)
 	}
 
 	parseLaravel(text, &out, guessedSet)
+	if ext == ".php" {
+		out.Endpoints = laravelEndpoints(full, rel)
+	}
 
 	parseExpress(text, &out, guessedSet)
 
@@ -401,15 +401,26 @@ func parseOpenAPIJSON(text string, out *RouteFile, set map[string]struct{}) {
 	if err := json.Unmarshal([]byte(text), &obj); err != nil {
 		return
 	}
-	paths, _ := obj["paths"].(map[string]any)
-	if paths == nil {
+	openAPIPaths(obj, out, set)
+}
+
+func parseOpenAPIYAML(text string, out *RouteFile, set map[string]struct{}) {
+	if !strings.Contains(text, "paths:") {
 		return
 	}
+	docs, _ := parseYAML(text)
+	for _, d := range docs {
+		if obj, ok := d.(map[string]any); ok {
+			openAPIPaths(obj, out, set)
+		}
+	}
+}
+
+// openAPIPaths menambahkan operasi dari objek "paths" spesifikasi OpenAPI/Swagger.
+func openAPIPaths(obj map[string]any, out *RouteFile, set map[string]struct{}) {
+	paths, _ := obj["paths"].(map[string]any)
 	for p, v := range paths {
 		ops, _ := v.(map[string]any)
-		if ops == nil {
-			continue
-		}
 		for verb := range ops {
 			lv := strings.ToLower(verb)
 			switch lv {
@@ -419,21 +430,3 @@ func parseOpenAPIJSON(text string, out *RouteFile, set map[string]struct{}) {
 		}
 	}
 }
-
-func parseOpenAPIYAMLHeuristic(text string, out *RouteFile, set map[string]struct{}) {
-	if !strings.Contains(text, "paths:") {
-		return
-	}
-	lines := strings.Split(text, "\n")
-	var curPath string
-	for i := 0; i < len(lines); i++ {
-		l := lines[i]
-		if m := reYamlPathKey.FindStringSubmatch(l); len(m) == 2 {
-			curPath = m[1]
-			continue
-		}
-		if m := reYamlVerbKey.FindStringSubmatch(l); len(m) == 2 && curPath != "" {
-			addGuess(set, &out.Guessed, m[1], curPath)
-		}
-	}
-}
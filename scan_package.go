@@ -3,8 +3,8 @@ package main
 import (
 	"io/fs"
 	"os"
+	"path"
 	"path/filepath"
-	"regexp"
 	"slices"
 	"strings"
 )
@@ -40,6 +40,17 @@ func looksRouteText(path string) bool {
 	return false
 }
 
+// isOpenAPISpec: openapi.{yaml,yml,json} / swagger.* (dokumen spec, bukan kode)
+func isOpenAPISpec(rel string) bool {
+	base := strings.ToLower(path.Base(rel))
+	name := strings.TrimSuffix(base, path.Ext(base))
+	switch path.Ext(base) {
+	case ".yaml", ".yml", ".json":
+		return name == "openapi" || name == "swagger"
+	}
+	return false
+}
+
 func scanProject(root string, routeMaxLines int) (*CodeSummary, *LaravelCtx, []RouteFile, []string, []string) {
 	sum := &CodeSummary{Langs: map[string]int{}}
 	lctx := &LaravelCtx{}
@@ -47,10 +58,6 @@ func scanProject(root string, routeMaxLines int) (*CodeSummary, *LaravelCtx, []R
 	var migrations []string
 	var seeders []string
 
-	reNS := regexp.MustCompile(`(?m)^namespace\s+([^;]+);`)
-	reClass := regexp.MustCompile(`(?m)^(?:abstract\s+|final\s+)?class\s+([A-Za-z0-9_\\]+)`)
-	reMeth := regexp.MustCompile(`(?m)^(\s*)public\s+function\s+([A-Za-z0-9_]+)\s*\(`)
-
 	filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
 			return nil
@@ -86,32 +93,29 @@ func scanProject(root string, routeMaxLines int) (*CodeSummary, *LaravelCtx, []R
 
 		// ================= Laravel deep context (opsional, jika ada) =================
 		if strings.HasPrefix(rel, "app/") && ext == ".php" {
-			switch {
-			case strings.HasPrefix(rel, "app/Http/Controllers/"):
-				pc := parsePHP(path, reNS, reClass, reMeth)
-				pc.Path = rel
-				lctx.Controllers = append(lctx.Controllers, pc)
-			case strings.HasPrefix(rel, "app/Http/Middleware/"):
-				pc := parsePHP(path, reNS, reClass, reMeth)
-				pc.Path = rel
-				lctx.Middleware = append(lctx.Middleware, pc)
-			case strings.HasPrefix(rel, "app/Models/"):
-				pc := parsePHP(path, reNS, reClass, reMeth)
-				pc.Path = rel
-				lctx.Models = append(lctx.Models, pc)
-			case strings.HasPrefix(rel, "app/Traits/"):
-				pc := parsePHP(path, reNS, reClass, reMeth)
-				pc.Path = rel
-				lctx.Traits = append(lctx.Traits, pc)
-			case strings.HasPrefix(rel, "app/Helpers/"):
-				pc := parsePHP(path, reNS, reClass, reMeth)
+			if dst := lctx.bucket(rel); dst != nil {
+				var pc PHPClassFile
+				switch dst {
+				case &lctx.Models:
+					pc = parseModel(path)
+				case &lctx.Commands:
+					pc = parseCommand(path)
+				case &lctx.Controllers:
+					pc = parseController(path)
+				case &lctx.Requests:
+					pc = parseFormRequest(path)
+				default:
+					pc = parsePHP(path)
+				}
 				pc.Path = rel
-				lctx.Helpers = append(lctx.Helpers, pc)
+				*dst = append(*dst, pc)
 			}
 		}
 
 		// ================= General routes discovery (multi-framework) =================
-		if routeScanExt[ext] {
+		if isOpenAPISpec(rel) {
+			routes = append(routes, readRouteFile(path, rel, routeMaxLines))
+		} else if routeScanExt[ext] {
 			// fast path: file “kemungkinan” berisi route berdasarkan nama, atau
 			// kalau di folder routes, urls.py, controllers, api, dsb → parse
 			if looksRouteText(rel) {
@@ -176,13 +180,16 @@ func scanProject(root string, routeMaxLines int) (*CodeSummary, *LaravelCtx, []R
 	})
 
 	// sorting
-	slices.SortFunc(lctx.Controllers, func(a, b PHPClassFile) int { return strings.Compare(a.Path, b.Path) })
-	slices.SortFunc(lctx.Middleware, func(a, b PHPClassFile) int { return strings.Compare(a.Path, b.Path) })
-	slices.SortFunc(lctx.Models, func(a, b PHPClassFile) int { return strings.Compare(a.Path, b.Path) })
-	slices.SortFunc(lctx.Traits, func(a, b PHPClassFile) int { return strings.Compare(a.Path, b.Path) })
-	slices.SortFunc(lctx.Helpers, func(a, b PHPClassFile) int { return strings.Compare(a.Path, b.Path) })
+	for _, b := range lctx.buckets() {
+		slices.SortFunc(*b, func(a, b PHPClassFile) int { return strings.Compare(a.Path, b.Path) })
+	}
+	linkEloquentRelations(lctx.Models)
+	lctx.EventMap = laravelEventMap(root, lctx)
+	lctx.Schedule = readLaravelSchedule(root)
+	lctx.Config, lctx.ConfigEnv = readLaravelConfig(root)
 
 	slices.SortFunc(routes, func(a, b RouteFile) int { return strings.Compare(a.Path, b.Path) })
+	linkLaravelEndpoints(routes, lctx)
 	slices.Sort(migrations)
 	slices.Sort(seeders)
 	return sum, lctx, routes, migrations, seeders
@@ -0,0 +1,668 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// table mengembalikan tabel dengan nama tersebut, dibuat jika belum ada.
+func (s *DBSchema) table(name string) *DBTable {
+	for i := range s.Tables {
+		if strings.EqualFold(s.Tables[i].Name, name) {
+			return &s.Tables[i]
+		}
+	}
+	s.Tables = append(s.Tables, DBTable{Name: name})
+	return &s.Tables[len(s.Tables)-1]
+}
+
+func (s *DBSchema) lookup(name string) *DBTable {
+	for i := range s.Tables {
+		if strings.EqualFold(s.Tables[i].Name, name) {
+			return &s.Tables[i]
+		}
+	}
+	return nil
+}
+
+func (s *DBSchema) dropTable(name string) {
+	s.Tables = slices.DeleteFunc(s.Tables, func(t DBTable) bool { return strings.EqualFold(t.Name, name) })
+}
+
+func (s *DBSchema) renameTable(from, to string) {
+	if t := s.lookup(from); t != nil {
+		t.Name = to
+	}
+	for i := range s.Tables {
+		for j := range s.Tables[i].ForeignKeys {
+			if strings.EqualFold(s.Tables[i].ForeignKeys[j].RefTable, from) {
+				s.Tables[i].ForeignKeys[j].RefTable = to
+			}
+		}
+	}
+}
+
+// column mengembalikan kolom dengan nama tersebut, dibuat jika belum ada.
+func (t *DBTable) column(name string) *DBColumn {
+	for i := range t.Columns {
+		if strings.EqualFold(t.Columns[i].Name, name) {
+			return &t.Columns[i]
+		}
+	}
+	t.Columns = append(t.Columns, DBColumn{Name: name})
+	return &t.Columns[len(t.Columns)-1]
+}
+
+func (t *DBTable) hasColumn(name string) bool {
+	for _, c := range t.Columns {
+		if strings.EqualFold(c.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// dropColumn menghapus kolom beserta index/foreign key yang memakainya.
+func (t *DBTable) dropColumn(name string) {
+	t.Columns = slices.DeleteFunc(t.Columns, func(c DBColumn) bool { return strings.EqualFold(c.Name, name) })
+	uses := func(cols []string) bool {
+		return slices.ContainsFunc(cols, func(c string) bool { return strings.EqualFold(c, name) })
+	}
+	t.Indexes = slices.DeleteFunc(t.Indexes, func(ix DBIndex) bool { return uses(ix.Columns) })
+	t.ForeignKeys = slices.DeleteFunc(t.ForeignKeys, func(fk DBForeignKey) bool { return uses(fk.Columns) })
+}
+
+func (t *DBTable) renameColumn(from, to string) {
+	for i := range t.Columns {
+		if strings.EqualFold(t.Columns[i].Name, from) {
+			t.Columns[i].Name = to
+		}
+	}
+	rename := func(cols []string) {
+		for i := range cols {
+			if strings.EqualFold(cols[i], from) {
+				cols[i] = to
+			}
+		}
+	}
+	for i := range t.Indexes {
+		rename(t.Indexes[i].Columns)
+	}
+	for i := range t.ForeignKeys {
+		rename(t.ForeignKeys[i].Columns)
+	}
+}
+
+func (t *DBTable) addIndex(ix DBIndex) {
+	if ix.Primary {
+		for _, c := range ix.Columns {
+			if t.hasColumn(c) {
+				t.column(c).Primary = true
+			}
+		}
+	}
+	if ix.Unique && len(ix.Columns) == 1 && t.hasColumn(ix.Columns[0]) {
+		t.column(ix.Columns[0]).Unique = true
+	}
+	t.Indexes = append(t.Indexes, ix)
+}
+
+// dropIndex menghapus index berdasarkan nama atau daftar kolom.
+func (t *DBTable) dropIndex(name string, cols []string) {
+	t.Indexes = slices.DeleteFunc(t.Indexes, func(ix DBIndex) bool {
+		match := (name != "" && strings.EqualFold(ix.Name, name)) || (len(cols) > 0 && slices.Equal(ix.Columns, cols))
+		if match && len(ix.Columns) == 1 && t.hasColumn(ix.Columns[0]) {
+			c := t.column(ix.Columns[0])
+			if ix.Unique {
+				c.Unique = false
+			}
+			if ix.Primary {
+				c.Primary = false
+			}
+		}
+		return match
+	})
+}
+
+func (t *DBTable) dropForeign(name string, cols []string) {
+	t.ForeignKeys = slices.DeleteFunc(t.ForeignKeys, func(fk DBForeignKey) bool {
+		return (name != "" && strings.EqualFold(fk.Name, name)) || (len(cols) > 0 && slices.Equal(fk.Columns, cols))
+	})
+}
+
+// mermaidER merender schema sebagai Mermaid erDiagram.
+func mermaidER(s *DBSchema) string {
+	if s == nil || len(s.Tables) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("erDiagram\n")
+	for _, t := range s.Tables {
+		fkCols := map[string]bool{}
+		for _, fk := range t.ForeignKeys {
+			for _, c := range fk.Columns {
+				fkCols[strings.ToLower(c)] = true
+			}
+		}
+		fmt.Fprintf(&b, "    %s {\n", mermaidIdent(t.Name))
+		for _, c := range t.Columns {
+			var keys []string
+			if c.Primary {
+				keys = append(keys, "PK")
+			}
+			if fkCols[strings.ToLower(c.Name)] {
+				keys = append(keys, "FK")
+			}
+			if c.Unique {
+				keys = append(keys, "UK")
+			}
+			typ := c.Type
+			if i := strings.IndexByte(typ, '('); i > 0 {
+				typ = typ[:i]
+			}
+			line := fmt.Sprintf("        %s %s", mermaidIdent(typ), mermaidIdent(c.Name))
+			if len(keys) > 0 {
+				line += " " + strings.Join(keys, ",")
+			}
+			b.WriteString(line + "\n")
+		}
+		b.WriteString("    }\n")
+	}
+	for _, t := range s.Tables {
+		for _, fk := range t.ForeignKeys {
+			card := "o{"
+			for _, ix := range t.Indexes {
+				if (ix.Unique || ix.Primary) && slices.Equal(ix.Columns, fk.Columns) {
+					card = "o|"
+				}
+			}
+			fmt.Fprintf(&b, "    %s ||--%s %s : \"%s\"\n", mermaidIdent(fk.RefTable), card, mermaidIdent(t.Name), strings.Join(fk.Columns, ","))
+		}
+	}
+	return b.String()
+}
+
+func mermaidIdent(s string) string {
+	s = strings.Trim(s, "`\"[]")
+	var b strings.Builder
+	for _, r := range s {
+		if r == '_' || r == '-' || (r >= '0' && r <= '9') || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') {
+			b.WriteRune(r)
+		} else {
+			b.WriteByte('_')
+		}
+	}
+	if b.Len() == 0 {
+		return "unknown"
+	}
+	return b.String()
+}
+
+// ================= SQL DDL =================
+
+// sqlStatements memecah script SQL per `;`, mengabaikan komentar, string dan blok $$...$$.
+func sqlStatements(src string) []string {
+	var out []string
+	var b strings.Builder
+	for i := 0; i < len(src); i++ {
+		c := src[i]
+		switch {
+		case c == '-' && strings.HasPrefix(src[i:], "--"), c == '#' && (i == 0 || src[i-1] == '\n'):
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+			b.WriteByte('\n')
+		case c == '/' && strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				i = len(src)
+			} else {
+				i += end + 3
+			}
+			b.WriteByte(' ')
+		case c == '\'' || c == '"' || c == '`':
+			j := i + 1
+			for j < len(src) && src[j] != c {
+				if src[j] == '\\' && c == '\'' {
+					j++
+				}
+				j++
+			}
+			j = min(j, len(src)-1)
+			b.WriteString(src[i : j+1])
+			i = j
+		case c == '$':
+			// dollar quoting: $$ ... $$ / $fn$ ... $fn$
+			end := strings.IndexByte(src[i+1:], '$')
+			tag := ""
+			if end >= 0 {
+				tag = src[i : i+end+2]
+			}
+			if tag != "" && isSQLDollarTag(tag) {
+				rest := strings.Index(src[i+len(tag):], tag)
+				if rest < 0 {
+					rest = len(src) - i - len(tag)
+				} else {
+					rest += len(tag)
+				}
+				b.WriteString(src[i : i+len(tag)+rest])
+				i += len(tag) + rest - 1
+				continue
+			}
+			b.WriteByte(c)
+		case c == ';':
+			if t := strings.TrimSpace(b.String()); t != "" {
+				out = append(out, t)
+			}
+			b.Reset()
+		default:
+			b.WriteByte(c)
+		}
+	}
+	if t := strings.TrimSpace(b.String()); t != "" {
+		out = append(out, t)
+	}
+	return out
+}
+
+func isSQLDollarTag(tag string) bool {
+	for _, r := range tag[1 : len(tag)-1] {
+		if !(r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')) {
+			return false
+		}
+	}
+	return true
+}
+
+// sqlIdent membuang quote dan prefix schema: "public"."users" -> users.
+func sqlIdent(s string) string {
+	s = strings.TrimSpace(s)
+	parts := splitTopLevel(s, '.')
+	if len(parts) > 0 {
+		s = parts[len(parts)-1]
+	}
+	return strings.Trim(s, "`\"[]")
+}
+
+func sqlIdentList(s string) []string {
+	s = strings.TrimSpace(s)
+	s = strings.TrimSuffix(strings.TrimPrefix(s, "("), ")")
+	var out []string
+	for _, p := range splitTopLevel(s, ',') {
+		// kolom index bisa berisi arah/opclass: email DESC
+		f := strings.Fields(p)
+		if len(f) > 0 {
+			out = append(out, sqlIdent(f[0]))
+		}
+	}
+	return out
+}
+
+var (
+	reSQLCreateTable   = regexp.MustCompile(`(?is)^create\s+(?:(?:global\s+|local\s+)?(?:temporary|temp|unlogged)\s+)?table\s+(?:if\s+not\s+exists\s+)?([^\s(]+)\s*\((.*)\)`)
+	reSQLCreateIndex   = regexp.MustCompile(`(?is)^create\s+(unique\s+)?index\s+(?:concurrently\s+)?(?:if\s+not\s+exists\s+)?([^\s(]+)?\s*on\s+(?:only\s+)?([^\s(]+)\s*(?:using\s+(\w+)\s*)?(\(.*\))`)
+	reSQLAlterTable    = regexp.MustCompile(`(?is)^alter\s+table\s+(?:if\s+exists\s+)?(?:only\s+)?([^\s]+)\s+(.*)$`)
+	reSQLReferences    = regexp.MustCompile(`(?is)references\s+([^\s(]+)\s*(\([^)]*\))?(.*)$`)
+	reSQLOnAction      = regexp.MustCompile(`(?i)on\s+(delete|update)\s+(cascade|restrict|no\s+action|set\s+null|set\s+default)`)
+	reSQLConstraint    = regexp.MustCompile(`(?is)^(?:constraint\s+(\S+)\s+)?(primary\s+key|unique(?:\s+key|\s+index)?|foreign\s+key|key|index|check|exclude)\b\s*(\S*?)\s*(\(.*)?$`)
+	reSQLCreateView    = regexp.MustCompile(`(?is)^create\s+(?:or\s+replace\s+)?(?:(?:temp|temporary)\s+)?(?:algorithm\s*=\s*\w+\s+)?(?:definer\s*=\s*\S+\s+)?(?:sql\s+security\s+\w+\s+)?(materialized\s+)?view\s+(?:if\s+not\s+exists\s+)?([^\s(]+)(?:\s*\([^)]*\))?\s+as\s+(.*)$`)
+	reSQLFromJoin      = regexp.MustCompile(`(?i)\b(?:from|join)\s+([\w."\x60\[\]]+)`)
+	reSQLCreateTableAs = regexp.MustCompile(`(?is)^create\s+(?:(?:temporary|temp|unlogged)\s+)?table\s+(?:if\s+not\s+exists\s+)?([^\s(]+)\s+(?:as\s+|\(?\s*like\s+([^\s)]+))`)
+	reSQLDrop          = regexp.MustCompile(`(?is)^drop\s+(table|view|materialized\s+view|index)\s+(?:concurrently\s+)?(?:if\s+exists\s+)?(.+?)(?:\s+on\s+\S+)?(?:\s+(?:cascade|restrict))?$`)
+	reSQLRenameTable   = regexp.MustCompile(`(?is)^rename\s+table\s+(.+)$`)
+	reSQLUnique        = regexp.MustCompile(`\bunique\b`)
+	reSQLDefault       = regexp.MustCompile(`(?is)\bdefault\s+('(?:[^']|'')*'|\([^)]*\)|[^\s,]+(?:\([^)]*\))?)`)
+)
+
+// parseSQLSchema membaca DDL (CREATE/ALTER/DROP table, index, view) dari dump SQL.
+func parseSQLSchema(src string) *DBSchema {
+	s := &DBSchema{Source: "sql"}
+	for _, stmt := range sqlStatements(src) {
+		applySQL(s, stmt)
+	}
+	return s
+}
+
+// applySQL menerapkan satu statement DDL ke schema.
+func applySQL(s *DBSchema, stmt string) {
+	switch {
+	case reSQLCreateView.MatchString(stmt):
+		m := reSQLCreateView.FindStringSubmatch(stmt)
+		v := DBView{Name: sqlIdent(m[2]), Materialized: m[1] != ""}
+		for _, f := range reSQLFromJoin.FindAllStringSubmatch(m[3], -1) {
+			if t := sqlIdent(f[1]); !slices.Contains(v.Tables, t) {
+				v.Tables = append(v.Tables, t)
+			}
+		}
+		s.Views = slices.DeleteFunc(s.Views, func(x DBView) bool { return strings.EqualFold(x.Name, v.Name) })
+		s.Views = append(s.Views, v)
+	case reSQLCreateTable.MatchString(stmt):
+		m := reSQLCreateTable.FindStringSubmatch(stmt)
+		t := s.table(sqlIdent(m[1]))
+		for _, def := range splitTopLevel(m[2], ',') {
+			sqlTableElement(t, def)
+		}
+	case reSQLCreateTableAs.MatchString(stmt):
+		m := reSQLCreateTableAs.FindStringSubmatch(stmt)
+		t := s.table(sqlIdent(m[1]))
+		if src := s.lookup(sqlIdent(m[2])); m[2] != "" && src != nil {
+			t.Columns = slices.Clone(src.Columns)
+		}
+	case reSQLCreateIndex.MatchString(stmt):
+		m := reSQLCreateIndex.FindStringSubmatch(stmt)
+		if t := s.lookup(sqlIdent(m[3])); t != nil {
+			cols := m[5]
+			if end := matchClose(cols, 0); end > 0 {
+				cols = cols[:end+1]
+			}
+			t.addIndex(DBIndex{Name: sqlIdent(m[2]), Columns: sqlIdentList(cols), Unique: m[1] != "", Kind: strings.ToLower(m[4])})
+		}
+	case reSQLDrop.MatchString(stmt):
+		m := reSQLDrop.FindStringSubmatch(stmt)
+		kind := strings.ToLower(strings.Join(strings.Fields(m[1]), " "))
+		for _, name := range splitTopLevel(m[2], ',') {
+			w := strings.Fields(name)
+			if len(w) == 0 {
+				continue // `DROP TABLE a,,b` / koma di akhir
+			}
+			name = sqlIdent(w[0])
+			switch kind {
+			case "table":
+				s.dropTable(name)
+			case "view", "materialized view":
+				s.Views = slices.DeleteFunc(s.Views, func(v DBView) bool { return strings.EqualFold(v.Name, name) })
+			case "index":
+				// DROP INDEX name [ON table] (MySQL) / DROP INDEX name (Postgres/SQLite)
+				for i := range s.Tables {
+					s.Tables[i].dropIndex(name, nil)
+				}
+			}
+		}
+	case reSQLRenameTable.MatchString(stmt):
+		for _, pair := range splitTopLevel(reSQLRenameTable.FindStringSubmatch(stmt)[1], ',') {
+			if from, to, ok := sqlCutFold(pair, " to "); ok {
+				s.renameTable(sqlIdent(from), sqlIdent(to))
+			}
+		}
+	case reSQLAlterTable.MatchString(stmt):
+		m := reSQLAlterTable.FindStringSubmatch(stmt)
+		t := s.lookup(sqlIdent(m[1]))
+		if t == nil {
+			return
+		}
+		for _, action := range splitTopLevel(m[2], ',') {
+			if to, ok := sqlAlterTable(t, action); ok {
+				s.renameTable(t.Name, to)
+				t = s.lookup(to)
+			}
+		}
+	}
+}
+
+// sqlAlterTable menerapkan satu aksi ALTER TABLE; mengembalikan nama baru bila RENAME TO.
+func sqlAlterTable(t *DBTable, action string) (string, bool) {
+	f := strings.Fields(action)
+	if len(f) < 2 {
+		return "", false
+	}
+	var off []int // posisi awal tiap token f di action
+	space := true
+	for i, r := range action {
+		if !unicode.IsSpace(r) && space {
+			off = append(off, i)
+		}
+		space = unicode.IsSpace(r)
+	}
+	kw := func(i int) string {
+		if i < len(f) {
+			return strings.ToLower(f[i])
+		}
+		return ""
+	}
+	// lewati kata kunci opsional: COLUMN, IF [NOT] EXISTS
+	rest := func(from int) string {
+		i := from
+		if kw(i) == "column" {
+			i++
+		}
+		if kw(i) == "if" {
+			i += 2
+			if kw(i-1) == "not" {
+				i++
+			}
+		}
+		if i >= len(f) {
+			return ""
+		}
+		return strings.TrimSpace(action[off[i]:])
+	}
+	switch kw(0) {
+	case "add":
+		if kw(1) == "column" || sqlConstraint(strings.TrimSpace(action[3:])) == nil {
+			sqlTableElement(t, rest(1))
+		} else {
+			sqlTableElement(t, strings.TrimSpace(action[3:]))
+		}
+	case "drop":
+		switch kw(1) {
+		case "constraint", "index", "key", "foreign":
+			if len(f) < 3 {
+				return "", false
+			}
+			name := f[len(f)-1]
+			if (kw(len(f)-1) == "cascade" || kw(len(f)-1) == "restrict") && len(f) > 3 {
+				name = f[len(f)-2]
+			}
+			name = sqlIdent(name)
+			t.dropIndex(name, nil)
+			t.dropForeign(name, nil)
+		case "primary":
+			for _, ix := range t.Indexes {
+				if ix.Primary {
+					t.dropIndex("", ix.Columns)
+				}
+			}
+		default:
+			if c := strings.Fields(rest(1)); len(c) > 0 {
+				t.dropColumn(sqlIdent(c[0]))
+			}
+		}
+	case "rename":
+		switch {
+		case (kw(1) == "to" || kw(1) == "as") && len(f) > 2:
+			return sqlIdent(f[2]), true
+		case kw(1) == "column" && len(f) >= 5:
+			t.renameColumn(sqlIdent(f[2]), sqlIdent(f[4]))
+		case kw(1) == "index" || kw(1) == "key":
+			if len(f) >= 5 {
+				for i := range t.Indexes {
+					if strings.EqualFold(t.Indexes[i].Name, sqlIdent(f[2])) {
+						t.Indexes[i].Name = sqlIdent(f[4])
+					}
+				}
+			}
+		case len(f) >= 4 && kw(2) == "to":
+			t.renameColumn(sqlIdent(f[1]), sqlIdent(f[3]))
+		}
+	case "modify":
+		col, _ := sqlColumnDef(rest(1))
+		t.setColumn(col, true)
+	case "change":
+		// CHANGE [COLUMN] old new_definition (MySQL)
+		r := rest(1)
+		if old := strings.Fields(r); len(old) > 1 {
+			col, _ := sqlColumnDef(strings.TrimSpace(r[len(old[0]):]))
+			t.renameColumn(sqlIdent(old[0]), col.Name)
+			t.setColumn(col, true)
+		}
+	case "alter":
+		r := strings.Fields(rest(1))
+		if len(r) < 3 || !t.hasColumn(sqlIdent(r[0])) {
+			return "", false
+		}
+		c := t.column(sqlIdent(r[0]))
+		op := strings.ToLower(r[1] + " " + r[2])
+		switch {
+		case strings.EqualFold(r[1], "type"):
+			c.Type = strings.ToLower(sqlTypeOnly(strings.Join(r[2:], " ")))
+		case op == "set data" && len(r) > 4:
+			c.Type = strings.ToLower(sqlTypeOnly(strings.Join(r[4:], " ")))
+		case op == "set not":
+			c.Nullable = false
+		case op == "drop not":
+			c.Nullable = true
+		case op == "set default":
+			c.Default = strings.Trim(strings.Join(r[3:], " "), "'")
+		case op == "drop default":
+			c.Default = ""
+		}
+	}
+	return "", false
+}
+
+func sqlCutFold(s, sep string) (string, string, bool) {
+	i := strings.Index(strings.ToLower(s), sep)
+	if i < 0 {
+		return "", "", false
+	}
+	return s[:i], s[i+len(sep):], true
+}
+
+var reSQLIndexName = regexp.MustCompile(`^(?:"[^"]*"|\x60[^\x60]*\x60|\[[^\]]*\])\s*\(|^[\w.$]+\s+\(`)
+
+// sqlConstraint mencocokkan constraint tabel. KEY/INDEX/CHECK juga bisa nama kolom
+// ("key VARCHAR(10)", "check BOOLEAN"), jadi hanya dianggap constraint bila diikuti
+// "(" atau nama lalu spasi + "(" ("KEY idx (a)", "KEY `idx`(a)").
+func sqlConstraint(def string) []string {
+	loc := reSQLConstraint.FindStringSubmatchIndex(def)
+	if loc == nil {
+		return nil
+	}
+	switch strings.ToLower(def[loc[4]:loc[5]]) {
+	case "key", "index", "check":
+		after := strings.TrimSpace(def[loc[5]:])
+		if !strings.HasPrefix(after, "(") && !reSQLIndexName.MatchString(after) {
+			return nil
+		}
+	}
+	m := make([]string, len(loc)/2)
+	for i := range m {
+		if loc[2*i] >= 0 {
+			m[i] = def[loc[2*i]:loc[2*i+1]]
+		}
+	}
+	return m
+}
+
+// sqlTableElement memproses satu definisi kolom atau constraint di CREATE TABLE.
+func sqlTableElement(t *DBTable, def string) {
+	def = strings.TrimSpace(def)
+	if def == "" {
+		return
+	}
+	if m := sqlConstraint(def); m != nil {
+		kind := strings.ToLower(strings.Join(strings.Fields(m[2]), " "))
+		name := sqlIdent(m[1])
+		rest := m[4]
+		if rest == "" && strings.HasPrefix(m[3], "(") {
+			rest = m[3]
+		} else if m[3] != "" && name == "" && kind != "foreign key" {
+			name = sqlIdent(m[3])
+		}
+		end := matchClose(rest, 0)
+		if end < 0 {
+			return
+		}
+		cols := sqlIdentList(rest[:end+1])
+		switch {
+		case kind == "primary key":
+			t.addIndex(DBIndex{Name: name, Columns: cols, Primary: true})
+		case strings.HasPrefix(kind, "unique"):
+			t.addIndex(DBIndex{Name: name, Columns: cols, Unique: true})
+		case kind == "key" || kind == "index":
+			t.addIndex(DBIndex{Name: name, Columns: cols})
+		case kind == "foreign key":
+			fk := DBForeignKey{Name: name, Columns: cols}
+			if r := reSQLReferences.FindStringSubmatch(rest[end+1:]); r != nil {
+				fk.RefTable = sqlIdent(r[1])
+				fk.RefColumns = sqlIdentList(r[2])
+				sqlFKActions(&fk, r[3])
+			}
+			t.ForeignKeys = append(t.ForeignKeys, fk)
+		}
+		return
+	}
+	col, low := sqlColumnDef(def)
+	if col.Name == "" {
+		return
+	}
+	t.setColumn(col, false)
+	if strings.Contains(low, "primary key") {
+		t.addIndex(DBIndex{Columns: []string{col.Name}, Primary: true})
+	} else if reSQLUnique.MatchString(low) {
+		t.addIndex(DBIndex{Columns: []string{col.Name}, Unique: true})
+	}
+	if r := reSQLReferences.FindStringSubmatch(def); r != nil {
+		fk := DBForeignKey{Columns: []string{col.Name}, RefTable: sqlIdent(r[1]), RefColumns: sqlIdentList(r[2])}
+		sqlFKActions(&fk, r[3])
+		t.ForeignKeys = append(t.ForeignKeys, fk)
+	}
+}
+
+// sqlColumnDef membaca definisi kolom `name type [constraint...]`; low adalah sisa definisi (lowercase).
+func sqlColumnDef(def string) (col DBColumn, low string) {
+	f := strings.Fields(def)
+	if len(f) < 2 {
+		return DBColumn{}, ""
+	}
+	col = DBColumn{Name: sqlIdent(f[0]), Nullable: true}
+	rest := strings.TrimSpace(def[len(f[0]):])
+	col.Type = strings.ToLower(sqlTypeOnly(rest))
+	low = strings.ToLower(rest)
+	if strings.Contains(low, "not null") || strings.Contains(low, "primary key") {
+		col.Nullable = false
+	}
+	if strings.Contains(low, "unsigned") {
+		col.Unsigned = true
+	}
+	if strings.Contains(low, "auto_increment") || strings.Contains(low, "autoincrement") || strings.Contains(low, "identity") ||
+		strings.HasPrefix(col.Type, "serial") || strings.HasPrefix(col.Type, "bigserial") || strings.HasPrefix(col.Type, "smallserial") {
+		col.AutoIncrement = true
+	}
+	if m := reSQLDefault.FindStringSubmatch(rest); m != nil {
+		col.Default = strings.Trim(m[1], "'")
+	}
+	if strings.Contains(low, "primary key") {
+		col.Primary = true
+	}
+	return col, low
+}
+
+// sqlTypeOnly memotong constraint di belakang tipe: "varchar(255) not null default ”" -> "varchar(255)".
+// Tipe bisa multi-kata: character varying(255), double precision, timestamp without time zone.
+func sqlTypeOnly(rest string) string {
+	typ := rest
+	for _, kw := range []string{" not ", " null", " default ", " primary ", " unique", " references ", " constraint ", " check", " generated ", " collate ", " auto_increment", " autoincrement", " identity", " comment ", " unsigned", " on update "} {
+		if i := strings.Index(strings.ToLower(" "+typ+" "), kw); i >= 0 {
+			if i == 0 {
+				return ""
+			}
+			typ = typ[:i-1]
+		}
+	}
+	return strings.TrimSpace(typ)
+}
+
+func sqlFKActions(fk *DBForeignKey, s string) {
+	for _, a := range reSQLOnAction.FindAllStringSubmatch(s, -1) {
+		act := strings.ToLower(strings.Join(strings.Fields(a[2]), " "))
+		if strings.EqualFold(a[1], "delete") {
+			fk.OnDelete = act
+		} else {
+			fk.OnUpdate = act
+		}
+	}
+}
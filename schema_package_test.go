@@ -0,0 +1,96 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseSQLSchema(t *testing.T) {
+	cases := []struct {
+		name    string
+		src     string
+		columns string // nama:tipe dipisah koma
+		indexes string // JSON indexes tabel pertama
+		table   string
+	}{
+		{
+			name:    "key/index/check as column names",
+			src:     "CREATE TABLE users (id INT PRIMARY KEY, key VARCHAR(10) NOT NULL, check BOOLEAN, index INT, name TEXT);",
+			table:   "users",
+			columns: "id:int,key:varchar(10),check:boolean,index:int,name:text",
+			indexes: `[{"columns":["id"],"primary":true}]`,
+		},
+		{
+			name:    "key/index/check as constraints",
+			src:     "CREATE TABLE t (a INT, b INT, KEY idx_a (a), INDEX (b), KEY `ix_ab`(a, b), CHECK (a > 0), CONSTRAINT pk PRIMARY KEY (a));",
+			table:   "t",
+			columns: "a:int,b:int",
+			indexes: `[{"name":"idx_a","columns":["a"]},{"columns":["b"]},{"name":"ix_ab","columns":["a","b"]},{"name":"pk","columns":["a"],"primary":true}]`,
+		},
+		{
+			name:    "quoted key column",
+			src:     "CREATE TABLE t (`key` INT, \"index\" TEXT);",
+			table:   "t",
+			columns: "key:int,index:text",
+			indexes: `null`,
+		},
+		{
+			name:    "truncated rename does not panic",
+			src:     "CREATE TABLE users (id INT);\nALTER TABLE users RENAME TO;\nALTER TABLE users DROP CONSTRAINT;",
+			table:   "users",
+			columns: "id:int",
+			indexes: `null`,
+		},
+		{
+			name:    "lowercase alter add column",
+			src:     "create table p (id int);\nalter table p add column c int;\nalter table p add column if not exists x int;\nalter table p add y text;",
+			table:   "p",
+			columns: "id:int,c:int,x:int,y:text",
+			indexes: `null`,
+		},
+		{
+			name:    "lowercase alter drop column",
+			src:     "create table p (id int, x int, y int, z int);\nalter table p drop column if exists x;\nalter table p drop y;\nalter table p drop column z;",
+			table:   "p",
+			columns: "id:int",
+			indexes: `null`,
+		},
+		{
+			name:    "drop table with empty list elements",
+			src:     "CREATE TABLE a (id INT);\nCREATE TABLE b (id INT);\nCREATE TABLE c (id INT);\nDROP TABLE a,,b,;",
+			table:   "c",
+			columns: "id:int",
+			indexes: `null`,
+		},
+		{
+			name:    "rename table",
+			src:     "CREATE TABLE a (id INT);\nALTER TABLE a RENAME TO b;",
+			table:   "b",
+			columns: "id:int",
+			indexes: `null`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := parseSQLSchema(tc.src)
+			if len(s.Tables) != 1 || s.Tables[0].Name != tc.table {
+				t.Fatalf("tables = %+v, want single %q", s.Tables, tc.table)
+			}
+			tbl := s.Tables[0]
+			cols := ""
+			for i, c := range tbl.Columns {
+				if i > 0 {
+					cols += ","
+				}
+				cols += c.Name + ":" + c.Type
+			}
+			if cols != tc.columns {
+				t.Errorf("columns = %s, want %s", cols, tc.columns)
+			}
+			ix, _ := json.Marshal(tbl.Indexes)
+			if string(ix) != tc.indexes {
+				t.Errorf("indexes = %s, want %s", ix, tc.indexes)
+			}
+		})
+	}
+}
@@ -29,6 +29,18 @@ func toStrMap(x any) map[string]string {
 	return out
 }
 
+// toStrings mengambil elemen string dari []any (array TOML/JSON/YAML).
+func toStrings(x any) []string {
+	arr, _ := x.([]any)
+	var out []string
+	for _, v := range arr {
+		if s, ok := v.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
 func truncate(s string, n int) string {
 	if len(s) <= n {
 		return s
@@ -81,6 +93,39 @@ func unique[T comparable](in []T) []T {
 	return out
 }
 
+// direktori berat yang tidak pernah ikut di-scan
+var heavyDirs = []string{".git/", ".idea/", ".vscode/", "node_modules/", "vendor/", "storage/", "bootstrap/cache/", "public/", ".next/", "dist/", "build/"}
+
+func skipHeavyDir(rel string) bool {
+	if hasAnyPrefix(rel+"/", heavyDirs...) {
+		return true
+	}
+	switch filepath.Base(rel) {
+	case ".git", "node_modules", "vendor", "__pycache__", ".venv", "venv", "target":
+		return true
+	}
+	return false
+}
+
+// walkFiles memanggil fn untuk setiap file di luar direktori berat (rel sudah slash).
+func walkFiles(root string, fn func(full, rel string)) {
+	filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		rel, _ := filepath.Rel(root, path)
+		rel = filepath.ToSlash(rel)
+		if d.IsDir() {
+			if rel != "." && skipHeavyDir(rel) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		fn(path, rel)
+		return nil
+	})
+}
+
 func exists(p string) bool {
 	_, err := os.Stat(p)
 	return err == nil
@@ -116,6 +161,74 @@ func filesExist(root string, globs []string) bool {
 	return found
 }
 
+// splitTopLevel memecah s dengan sep, mengabaikan sep di dalam kurung atau string.
+func splitTopLevel(s string, sep byte) []string {
+	var out []string
+	depth := 0
+	var quote byte
+	start := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if quote != 0 {
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+			continue
+		}
+		switch c {
+		case '"', '\'', '`':
+			quote = c
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			depth--
+		case sep:
+			if depth == 0 {
+				out = append(out, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if rest := strings.TrimSpace(s[start:]); rest != "" {
+		out = append(out, rest)
+	}
+	return out
+}
+
+// matchClose mengembalikan index penutup untuk pembuka di s[open] (bracket/brace/paren), atau -1.
+func matchClose(s string, open int) int {
+	if open < 0 || open >= len(s) {
+		return -1
+	}
+	depth := 0
+	var quote byte
+	for i := open; i < len(s); i++ {
+		c := s[i]
+		if quote != 0 {
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+			continue
+		}
+		switch c {
+		case '"', '\'', '`':
+			quote = c
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
 func firstGroup(m [][]byte, idx int) string {
 	if len(m) == 0 {
 		return ""
@@ -0,0 +1,546 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ================= TOML =================
+//
+// Decoder TOML 1.0 tanpa dependency: tabel menjadi map[string]any, array []any,
+// integer int64, float float64, datetime (dan inf/nan) tetap string apa adanya.
+
+// readTOML membaca file TOML; bila ada error sintaks, hasil parsial tetap dikembalikan.
+func readTOML(full string) map[string]any {
+	b, err := os.ReadFile(full)
+	if err != nil {
+		return map[string]any{}
+	}
+	m, _ := parseTOML(string(b))
+	return m
+}
+
+type tomlParser struct {
+	src     string
+	pos     int
+	root    map[string]any
+	cur     map[string]any
+	defined map[string]bool // header [a.b] yang sudah dideklarasikan
+	arrays  map[string]bool // header [[a.b]]
+	static  map[string]bool // key berisi inline table/array statis; tidak bisa diperluas
+	path    []string        // key header aktif
+	inline  int             // kedalaman inline table
+}
+
+func parseTOML(src string) (map[string]any, error) {
+	p := &tomlParser{src: strings.TrimPrefix(src, "\ufeff"), root: map[string]any{},
+		defined: map[string]bool{}, arrays: map[string]bool{}, static: map[string]bool{}}
+	p.cur = p.root
+	for {
+		p.skipBlank(true)
+		if p.pos >= len(p.src) {
+			return p.root, nil
+		}
+		var err error
+		if p.src[p.pos] == '[' {
+			err = p.header()
+		} else {
+			err = p.keyValue(p.cur)
+		}
+		if err == nil {
+			err = p.endOfLine()
+		}
+		if err != nil {
+			return p.root, err
+		}
+	}
+}
+
+func (p *tomlParser) errorf(format string, args ...any) error {
+	line := 1 + strings.Count(p.src[:min(p.pos, len(p.src))], "\n")
+	return fmt.Errorf("toml line %d: %s", line, fmt.Sprintf(format, args...))
+}
+
+func (p *tomlParser) peek(s string) bool { return strings.HasPrefix(p.src[p.pos:], s) }
+
+// skipBlank melewati spasi/tab dan komentar; newline juga bila nl.
+func (p *tomlParser) skipBlank(nl bool) {
+	for p.pos < len(p.src) {
+		switch c := p.src[p.pos]; {
+		case c == ' ' || c == '\t':
+			p.pos++
+		case nl && (c == '\n' || c == '\r'):
+			p.pos++
+		case c == '#':
+			for p.pos < len(p.src) && p.src[p.pos] != '\n' {
+				p.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (p *tomlParser) endOfLine() error {
+	p.skipBlank(false)
+	switch {
+	case p.pos >= len(p.src):
+	case p.peek("\n"):
+		p.pos++
+	case p.peek("\r\n"):
+		p.pos += 2
+	default:
+		return p.errorf("expected newline, got %q", p.src[p.pos])
+	}
+	return nil
+}
+
+// header: [a.b] atau [[a.b]].
+func (p *tomlParser) header() error {
+	array := p.peek("[[")
+	p.pos++
+	if array {
+		p.pos++
+	}
+	p.skipBlank(false)
+	keys, err := p.key()
+	if err != nil {
+		return err
+	}
+	p.skipBlank(false)
+	closing := "]"
+	if array {
+		closing = "]]"
+	}
+	if !p.peek(closing) {
+		return p.errorf("unterminated table header")
+	}
+	p.pos += len(closing)
+
+	parent, err := p.table(p.root, nil, keys[:len(keys)-1])
+	if err != nil {
+		return err
+	}
+	last := keys[len(keys)-1]
+	name := strings.Join(keys, "\x00")
+	p.path = keys
+	if array {
+		arr, ok := parent[last].([]any)
+		if _, exists := parent[last]; exists && (!ok || !p.arrays[name]) {
+			return p.errorf("%s is not an array of tables", strings.Join(keys, "."))
+		}
+		p.arrays[name] = true
+		t := map[string]any{}
+		parent[last] = append(arr, t)
+		p.cur = t
+		// sub-tabel dan key dari elemen sebelumnya boleh dideklarasikan ulang
+		for _, m := range []map[string]bool{p.defined, p.static} {
+			for k := range m {
+				if strings.HasPrefix(k, name+"\x00") {
+					delete(m, k)
+				}
+			}
+		}
+		return nil
+	}
+	if p.defined[name] {
+		return p.errorf("table %s defined twice", strings.Join(keys, "."))
+	}
+	p.defined[name] = true
+	t, err := p.table(parent, keys[:len(keys)-1], []string{last})
+	if err != nil {
+		return err
+	}
+	p.cur = t
+	return nil
+}
+
+// table menelusuri/membuat tabel bersarang di bawah path base; array of tables
+// memakai elemen terakhir.
+func (p *tomlParser) table(t map[string]any, base, keys []string) (map[string]any, error) {
+	for i, k := range keys {
+		if p.inline == 0 && p.static[p.name(base, keys[:i+1])] {
+			return nil, p.errorf("key %s is static and cannot be extended", k)
+		}
+		switch v := t[k].(type) {
+		case nil:
+			n := map[string]any{}
+			t[k] = n
+			t = n
+		case map[string]any:
+			t = v
+		case []any:
+			last, ok := any(nil), false
+			if len(v) > 0 {
+				last = v[len(v)-1]
+			}
+			if t, ok = last.(map[string]any); !ok {
+				return nil, p.errorf("key %s is not a table", k)
+			}
+		default:
+			return nil, p.errorf("key %s is already a value", k)
+		}
+	}
+	return t, nil
+}
+
+func (p *tomlParser) keyValue(t map[string]any) error {
+	keys, err := p.key()
+	if err != nil {
+		return err
+	}
+	p.skipBlank(false)
+	if !p.peek("=") {
+		return p.errorf("expected = after key %s", strings.Join(keys, "."))
+	}
+	p.pos++
+	p.skipBlank(false)
+	v, err := p.value()
+	if err != nil {
+		return err
+	}
+	parent, err := p.table(t, p.path, keys[:len(keys)-1])
+	if err != nil {
+		return err
+	}
+	last := keys[len(keys)-1]
+	if _, dup := parent[last]; dup {
+		return p.errorf("duplicate key %s", strings.Join(keys, "."))
+	}
+	parent[last] = v
+	switch v.(type) {
+	case map[string]any, []any:
+		if p.inline == 0 {
+			p.static[p.name(p.path, keys)] = true
+		}
+	}
+	return nil
+}
+
+// name menggabungkan path header dan key relatif menjadi key map defined/static.
+func (p *tomlParser) name(base, keys []string) string {
+	return strings.Join(append(append([]string{}, base...), keys...), "\x00")
+}
+
+// key membaca key (bare, "basic", 'literal') dengan titik sebagai pemisah.
+func (p *tomlParser) key() ([]string, error) {
+	var keys []string
+	for {
+		p.skipBlank(false)
+		if p.pos >= len(p.src) {
+			return nil, p.errorf("unexpected end of input in key")
+		}
+		switch p.src[p.pos] {
+		case '"':
+			s, err := p.basicString()
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, s)
+		case '\'':
+			s, err := p.literalString()
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, s)
+		default:
+			start := p.pos
+			for p.pos < len(p.src) && tomlBareKeyChar(p.src[p.pos]) {
+				p.pos++
+			}
+			if start == p.pos {
+				return nil, p.errorf("invalid key character %q", p.src[p.pos])
+			}
+			keys = append(keys, p.src[start:p.pos])
+		}
+		p.skipBlank(false)
+		if !p.peek(".") {
+			return keys, nil
+		}
+		p.pos++
+	}
+}
+
+func tomlBareKeyChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-'
+}
+
+func (p *tomlParser) value() (any, error) {
+	if p.pos >= len(p.src) {
+		return nil, p.errorf("missing value")
+	}
+	switch {
+	case p.peek(`"""`):
+		return p.multiBasicString()
+	case p.peek(`'''`):
+		return p.multiLiteralString()
+	case p.peek(`"`):
+		return p.basicString()
+	case p.peek(`'`):
+		return p.literalString()
+	case p.peek("["):
+		return p.array()
+	case p.peek("{"):
+		return p.inlineTable()
+	case p.peek("true"):
+		p.pos += 4
+		return true, nil
+	case p.peek("false"):
+		p.pos += 5
+		return false, nil
+	}
+	return p.scalar()
+}
+
+func (p *tomlParser) array() (any, error) {
+	p.pos++ // [
+	out := []any{}
+	for {
+		p.skipBlank(true)
+		if p.peek("]") {
+			p.pos++
+			return out, nil
+		}
+		v, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+		p.skipBlank(true)
+		switch {
+		case p.peek(","):
+			p.pos++
+		case p.peek("]"):
+		default:
+			return nil, p.errorf("expected , or ] in array")
+		}
+	}
+}
+
+func (p *tomlParser) inlineTable() (any, error) {
+	p.pos++ // {
+	p.inline++
+	defer func() { p.inline-- }()
+	out := map[string]any{}
+	p.skipBlank(false)
+	if p.peek("}") {
+		p.pos++
+		return out, nil
+	}
+	for {
+		// TOML 1.1 mengizinkan newline di inline table; diterima juga
+		p.skipBlank(true)
+		if p.peek("}") {
+			p.pos++
+			return out, nil
+		}
+		if err := p.keyValue(out); err != nil {
+			return nil, err
+		}
+		p.skipBlank(true)
+		switch {
+		case p.peek(","):
+			p.pos++
+		case p.peek("}"):
+			p.pos++
+			return out, nil
+		default:
+			return nil, p.errorf("expected , or } in inline table")
+		}
+	}
+}
+
+func (p *tomlParser) literalString() (string, error) {
+	end := strings.IndexAny(p.src[p.pos+1:], "'\n")
+	if end < 0 || p.src[p.pos+1+end] != '\'' {
+		return "", p.errorf("unterminated literal string")
+	}
+	s := p.src[p.pos+1 : p.pos+1+end]
+	p.pos += end + 2
+	return s, nil
+}
+
+func (p *tomlParser) multiLiteralString() (string, error) {
+	p.pos += 3
+	p.skipNewline()
+	end := strings.Index(p.src[p.pos:], `'''`)
+	if end < 0 {
+		return "", p.errorf("unterminated multi-line literal string")
+	}
+	// maksimal dua kutip tambahan milik isi string
+	for extra := 0; extra < 2 && strings.HasPrefix(p.src[p.pos+end+3:], "'"); extra++ {
+		end++
+	}
+	s := p.src[p.pos : p.pos+end]
+	p.pos += end + 3
+	return s, nil
+}
+
+func (p *tomlParser) skipNewline() {
+	if p.peek("\r\n") {
+		p.pos += 2
+	} else if p.peek("\n") {
+		p.pos++
+	}
+}
+
+func (p *tomlParser) basicString() (string, error) {
+	p.pos++
+	var sb strings.Builder
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		switch c {
+		case '"':
+			p.pos++
+			return sb.String(), nil
+		case '\n':
+			return "", p.errorf("newline in basic string")
+		case '\\':
+			if err := p.escape(&sb); err != nil {
+				return "", err
+			}
+		default:
+			sb.WriteByte(c)
+			p.pos++
+		}
+	}
+	return "", p.errorf("unterminated basic string")
+}
+
+func (p *tomlParser) multiBasicString() (string, error) {
+	p.pos += 3
+	p.skipNewline()
+	var sb strings.Builder
+	for p.pos < len(p.src) {
+		if p.peek(`"""`) {
+			extra := 0
+			for extra < 2 && strings.HasPrefix(p.src[p.pos+3+extra:], `"`) {
+				extra++
+			}
+			sb.WriteString(strings.Repeat(`"`, extra))
+			p.pos += 3 + extra
+			return sb.String(), nil
+		}
+		c := p.src[p.pos]
+		if c != '\\' {
+			sb.WriteByte(c)
+			p.pos++
+			continue
+		}
+		// line ending backslash: buang whitespace sampai karakter berikutnya
+		rest := strings.TrimLeft(p.src[p.pos+1:], " \t")
+		if strings.HasPrefix(rest, "\n") || strings.HasPrefix(rest, "\r\n") {
+			p.pos = len(p.src) - len(strings.TrimLeft(rest, " \t\r\n"))
+			continue
+		}
+		if err := p.escape(&sb); err != nil {
+			return "", err
+		}
+	}
+	return "", p.errorf("unterminated multi-line basic string")
+}
+
+// escape menulis satu escape sequence mulai dari backslash di p.pos.
+func (p *tomlParser) escape(sb *strings.Builder) error {
+	if p.pos+1 >= len(p.src) {
+		return p.errorf("unterminated escape")
+	}
+	c := p.src[p.pos+1]
+	p.pos += 2
+	switch c {
+	case 'b':
+		sb.WriteByte('\b')
+	case 't':
+		sb.WriteByte('\t')
+	case 'n':
+		sb.WriteByte('\n')
+	case 'f':
+		sb.WriteByte('\f')
+	case 'r':
+		sb.WriteByte('\r')
+	case 'e':
+		sb.WriteByte(0x1b)
+	case '"':
+		sb.WriteByte('"')
+	case '\\':
+		sb.WriteByte('\\')
+	case 'u', 'U':
+		n := 4
+		if c == 'U' {
+			n = 8
+		}
+		if p.pos+n > len(p.src) {
+			return p.errorf("short unicode escape")
+		}
+		r, err := strconv.ParseUint(p.src[p.pos:p.pos+n], 16, 32)
+		if err != nil || !utf8.ValidRune(rune(r)) {
+			return p.errorf("invalid unicode escape \\%c%s", c, p.src[p.pos:p.pos+n])
+		}
+		sb.WriteRune(rune(r))
+		p.pos += n
+	default:
+		return p.errorf("invalid escape \\%c", c)
+	}
+	return nil
+}
+
+// scalar: integer, float, atau date/time (disimpan sebagai string).
+func (p *tomlParser) scalar() (any, error) {
+	start := p.pos
+	for p.pos < len(p.src) && !strings.ContainsRune(" \t\r\n,]}#", rune(p.src[p.pos])) {
+		p.pos++
+	}
+	tok := p.src[start:p.pos]
+	// "1979-05-27 07:32:00": tanggal dan waktu dipisah spasi
+	if len(tok) == 10 && tok[4] == '-' && p.pos+3 < len(p.src) && p.src[p.pos] == ' ' && tomlDigit(p.src[p.pos+1]) && tomlDigit(p.src[p.pos+2]) && p.src[p.pos+3] == ':' {
+		p.pos++
+		for p.pos < len(p.src) && !strings.ContainsRune(" \t\r\n,]}#", rune(p.src[p.pos])) {
+			p.pos++
+		}
+		tok = p.src[start:p.pos]
+	}
+	if tok == "" {
+		return nil, p.errorf("missing value")
+	}
+	// inf/nan tidak bisa di-encode JSON, jadi dibiarkan string
+	switch strings.TrimLeft(tok, "+-") {
+	case "inf", "nan":
+		return tok, nil
+	}
+	if len(tok) >= 8 && tomlDigit(tok[0]) && (tok[4] == '-' && tok[7] == '-' || tok[2] == ':' && tok[5] == ':') {
+		return tok, nil
+	}
+	if strings.HasPrefix(tok, "_") || strings.HasSuffix(tok, "_") || strings.Contains(tok, "__") {
+		return nil, p.errorf("invalid number %q", tok)
+	}
+	num := strings.ReplaceAll(tok, "_", "")
+	if len(num) > 2 && num[0] == '0' && strings.ContainsRune("xob", rune(num[1])) {
+		base := map[byte]int{'x': 16, 'o': 8, 'b': 2}[num[1]]
+		n, err := strconv.ParseInt(num[2:], base, 64)
+		if err != nil {
+			return nil, p.errorf("invalid integer %q", tok)
+		}
+		return n, nil
+	}
+	if strings.ContainsAny(num, ".eE") {
+		f, err := strconv.ParseFloat(num, 64)
+		if err != nil {
+			return nil, p.errorf("invalid float %q", tok)
+		}
+		return f, nil
+	}
+	digits := strings.TrimLeft(num, "+-")
+	if len(digits) > 1 && digits[0] == '0' {
+		return nil, p.errorf("leading zero in integer %q", tok)
+	}
+	n, err := strconv.ParseInt(num, 10, 64)
+	if err != nil {
+		return nil, p.errorf("invalid value %q", tok)
+	}
+	return n, nil
+}
+
+func tomlDigit(c byte) bool { return c >= '0' && c <= '9' }
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestParseTOML(t *testing.T) {
+	cases := []struct {
+		name string
+		src  string
+		want string // JSON hasil decode
+		err  string // substring error; kosong = harus sukses
+	}{
+		{
+			name: "scalars",
+			src:  "s = \"x\"\ni = 1_000\nh = 0xff\nf = 6.5e-1\nb = true\nd = 1979-05-27T07:32:00Z\nn = inf\n",
+			want: `{"b":true,"d":"1979-05-27T07:32:00Z","f":0.65,"h":255,"i":1000,"n":"inf","s":"x"}`,
+		},
+		{
+			name: "dotted keys",
+			src:  "a.b.c = 1\na.b.d = 2\n\"quoted.key\" = 3\nsite.\"google.com\" = true\n",
+			want: `{"a":{"b":{"c":1,"d":2}},"quoted.key":3,"site":{"google.com":true}}`,
+		},
+		{
+			name: "tables and sub-tables",
+			src:  "[package]\nname = \"x\"\n[dependencies]\nserde = \"1\"\n[target.'cfg(unix)'.dependencies]\nlibc = \"0.2\"\n",
+			want: `{"dependencies":{"serde":"1"},"package":{"name":"x"},"target":{"cfg(unix)":{"dependencies":{"libc":"0.2"}}}}`,
+		},
+		{
+			name: "array of tables",
+			src:  "[[package]]\nname = \"a\"\n[[package]]\nname = \"b\"\n[package.source]\nregistry = true\n[[bin.items]]\nx = 1\n",
+			want: `{"bin":{"items":[{"x":1}]},"package":[{"name":"a"},{"name":"b","source":{"registry":true}}]}`,
+		},
+		{
+			name: "inline tables and arrays",
+			src:  "dep = { version = \"1\", features = [\"a\", \"b\"], version2.ref = \"k\" }\nnested = [[1, 2], [\"x\"]]\nmulti = [\n  1, # komentar\n  2,\n]\n",
+			want: `{"dep":{"features":["a","b"],"version":"1","version2":{"ref":"k"}},"multi":[1,2],"nested":[[1,2],["x"]]}`,
+		},
+		{
+			name: "multi-line strings",
+			src:  "a = \"\"\"\nline1\nline2\"\"\"\nb = '''\n raw \\n '''\nc = \"\"\"\\\n    joined \\\n    text\"\"\"\nd = \"esc\\t\\u00e9\"\n",
+			want: `{"a":"line1\nline2","b":" raw \\n ","c":"joined text","d":"esc\té"}`,
+		},
+		{
+			name: "comments and blank lines",
+			src:  "# header\n\n[t] # table\nk = \"v # not comment\" # comment\n",
+			want: `{"t":{"k":"v # not comment"}}`,
+		},
+		{
+			name: "duplicate key",
+			src:  "a = 1\na = 2\n",
+			err:  "duplicate",
+		},
+		{
+			name: "duplicate dotted key over value",
+			src:  "a = 1\na.b = 2\n",
+			err:  "a",
+		},
+		{
+			name: "table redefinition",
+			src:  "[a]\nx = 1\n[a]\ny = 2\n",
+			err:  "a",
+		},
+		{
+			name: "table redefines inline table",
+			src:  "a = { x = 1 }\n[a]\ny = 2\n",
+			err:  "a",
+		},
+		{
+			name: "array of tables over static array",
+			src:  "a = [1]\n[[a]]\nx = 1\n",
+			err:  "a",
+		},
+		{
+			name: "unterminated string",
+			src:  "a = \"x\n",
+			err:  "line 1",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseTOML(tc.src)
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("err = %v, want %q", err, tc.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			b, _ := json.Marshal(got)
+			if string(b) != tc.want {
+				t.Errorf("got  %s\nwant %s", b, tc.want)
+			}
+		})
+	}
+}
@@ -17,6 +17,8 @@ type Manifest struct {
 	Dart     *DartInfo     `json:"dart,omitempty"`     // Dart/Flutter
 	Swift    *SwiftInfo    `json:"swift,omitempty"`    // Swift/SwiftPM/CocoaPods
 
+	Workspaces *WorkspaceInfo `json:"workspaces,omitempty"` // monorepo: package per workspace
+
 	EnvKeys     []string     `json:"env_keys,omitempty"`
 	CodeSummary *CodeSummary `json:"code_summary,omitempty"`
 
@@ -25,6 +27,24 @@ type Manifest struct {
 	Migrations []string    `json:"migrations,omitempty"`
 	Seeders    []string    `json:"seeders,omitempty"`
 
+	DatabaseSchema *DBSchema   `json:"database_schema,omitempty"`
+	SQLSchema      *DBSchema   `json:"sql_schema,omitempty"`  // replay file migration .sql
+	DataModels     []DataModel `json:"data_models,omitempty"` // Prisma/TypeORM/Sequelize/Drizzle
+
+	GraphQL *GraphQLInfo `json:"graphql,omitempty"`
+
+	JSOutline     *JSOutline     `json:"js_outline,omitempty"`     // export per module TS/JS
+	PythonOutline *PythonOutline `json:"python_outline,omitempty"` // class/def per module .py
+
+	Django *DjangoInfo `json:"django,omitempty"`
+	Rails  *RailsInfo  `json:"rails,omitempty"`
+	Spring *SpringCtx  `json:"spring,omitempty"`
+
+	JVMOutline []JavaClassFile `json:"jvm_outline,omitempty"` // type top-level per file .java/.kt
+
+	DependencyGraph *DepGraph `json:"dependency_graph,omitempty"`
+	Symbols         []Symbol  `json:"symbols,omitempty"` // index definisi ala ctags
+
 	Git           *GitInfo        `json:"git,omitempty"`
 	CustomSignals map[string]bool `json:"custom_signals,omitempty"`
 
@@ -34,12 +54,42 @@ type Manifest struct {
 	Samples []SampleFile `json:"samples,omitempty"`
 }
 
+// WorkspaceInfo merangkum package monorepo (npm/yarn/pnpm, go.work, Cargo, Maven, Gradle, Composer).
+type WorkspaceInfo struct {
+	Tools    []string        `json:"tools"` // npm, yarn, pnpm, lerna, turborepo, nx, go.work, cargo, maven, gradle, composer
+	Packages []Workspace     `json:"packages"`
+	Edges    []WorkspaceEdge `json:"edges,omitempty"` // dependency antar package workspace
+}
+
+// Workspace adalah satu package di monorepo beserta hasil detector di direktorinya.
+type Workspace struct {
+	Name    string   `json:"name"`
+	Path    string   `json:"path"`
+	Sources []string `json:"sources"` // tool yang mendaftarkan package ini
+
+	Composer *ComposerInfo `json:"composer,omitempty"`
+	Node     *NodeInfo     `json:"node,omitempty"`
+	Go       *GoInfo       `json:"go,omitempty"`
+	Python   *PythonInfo   `json:"python,omitempty"`
+	Rust     *RustInfo     `json:"rust,omitempty"`
+	Java     *JavaInfo     `json:"java,omitempty"`
+	Dart     *DartInfo     `json:"dart,omitempty"`
+}
+
+type WorkspaceEdge struct {
+	From string `json:"from"` // path workspace
+	To   string `json:"to"`
+	Kind string `json:"kind"` // node, composer, go, cargo, maven, gradle
+	Dev  bool   `json:"dev,omitempty"`
+}
+
 type ComposerInfo struct {
 	Name         string            `json:"name,omitempty"`
 	Type         string            `json:"type,omitempty"`
 	Require      map[string]string `json:"require,omitempty"`
 	RequireDev   map[string]string `json:"require_dev,omitempty"`
 	AutoloadPSR4 map[string]string `json:"autoload_psr4,omitempty"`
+	Lock         *LockInfo         `json:"lock,omitempty"` // composer.lock
 }
 
 type NodeInfo struct {
@@ -51,38 +101,237 @@ type NodeInfo struct {
 	Expo            bool              `json:"expo,omitempty"`
 	ReactNative     bool              `json:"react_native,omitempty"`
 	Typescript      bool              `json:"typescript,omitempty"`
+	Lock            *LockInfo         `json:"lock,omitempty"` // package-lock/yarn/pnpm
 }
 
 type GoInfo struct {
-	Module   string   `json:"module,omitempty"`
-	Requires []string `json:"requires,omitempty"`
+	Module    string         `json:"module,omitempty"`
+	GoVersion string         `json:"go_version,omitempty"` // direktif go
+	Toolchain string         `json:"toolchain,omitempty"`
+	Requires  []string       `json:"requires,omitempty"` // path saja (kompatibel)
+	Require   []GoRequire    `json:"require,omitempty"`
+	Replace   []GoReplace    `json:"replace,omitempty"`
+	Exclude   []GoModVersion `json:"exclude,omitempty"`
+	Retract   []string       `json:"retract,omitempty"`  // versi atau rentang "[v1.0.0, v1.1.0]"
+	Work      *GoWork        `json:"work,omitempty"`     // go.work
+	Packages  []GoPackage    `json:"packages,omitempty"` // outline via go/parser
+	Lock      *LockInfo      `json:"lock,omitempty"`     // go.sum
+}
+
+type GoRequire struct {
+	Path     string `json:"path"`
+	Version  string `json:"version"`
+	Indirect bool   `json:"indirect,omitempty"`
+}
+
+type GoModVersion struct {
+	Path    string `json:"path"`
+	Version string `json:"version"`
+}
+
+type GoReplace struct {
+	Old        string `json:"old"`
+	OldVersion string `json:"old_version,omitempty"`
+	New        string `json:"new"`
+	NewVersion string `json:"new_version,omitempty"`
+	Local      bool   `json:"local,omitempty"`  // target direktori (./ atau ../)
+	Module     string `json:"module,omitempty"` // module path di go.mod target lokal
+	Dir        string `json:"dir,omitempty"`    // target lokal relatif root scan (bila di dalamnya)
+}
+
+type GoWork struct {
+	GoVersion string      `json:"go_version,omitempty"`
+	Toolchain string      `json:"toolchain,omitempty"`
+	Use       []GoWorkUse `json:"use,omitempty"`
+	Replace   []GoReplace `json:"replace,omitempty"`
+}
+
+type GoWorkUse struct {
+	Dir    string `json:"dir"`
+	Module string `json:"module,omitempty"`
+}
+
+// GoPackage adalah satu direktori package Go (file _test.go hanya dihitung).
+type GoPackage struct {
+	ImportPath string   `json:"import_path"`
+	Dir        string   `json:"dir"`
+	Name       string   `json:"name"`
+	Doc        string   `json:"doc,omitempty"`
+	Main       bool     `json:"main,omitempty"`
+	Binary     string   `json:"binary,omitempty"` // nama binary untuk package main
+	Files      int      `json:"files"`
+	TestFiles  int      `json:"test_files,omitempty"`
+	Tests      int      `json:"tests,omitempty"` // Test*/Benchmark*/Fuzz*/Example*
+	Imports    []string `json:"imports,omitempty"`
+	Types      []GoType `json:"types,omitempty"`
+	Funcs      []GoFunc `json:"funcs,omitempty"`
+}
+
+type GoType struct {
+	Name    string   `json:"name"`
+	Kind    string   `json:"kind"` // struct, interface, func, alias, atau tipe dasarnya
+	File    string   `json:"file"`
+	Line    int      `json:"line"`
+	Doc     string   `json:"doc,omitempty"`
+	Fields  []string `json:"fields,omitempty"`  // struct: field exported / embedded
+	Methods []string `json:"methods,omitempty"` // interface: method set; lainnya: method exported
+
+	methods []GoFunc // lokasi method exported (untuk index symbol)
+}
+
+type GoFunc struct {
+	Name      string `json:"name"`
+	Signature string `json:"signature"`
+	File      string `json:"file"`
+	Line      int    `json:"line"`
+	Doc       string `json:"doc,omitempty"`
 }
 
 type PythonInfo struct {
-	HasPip       bool              `json:"has_pip"`
-	HasPoetry    bool              `json:"has_poetry"`
-	HasPipenv    bool              `json:"has_pipenv"`
-	Requirements map[string]string `json:"requirements,omitempty"`
-	PyProject    map[string]any    `json:"pyproject,omitempty"`
+	HasPip           bool                         `json:"has_pip"`
+	HasPoetry        bool                         `json:"has_poetry"`
+	HasPipenv        bool                         `json:"has_pipenv"`
+	HasUV            bool                         `json:"has_uv,omitempty"`
+	HasConda         bool                         `json:"has_conda,omitempty"`
+	BuildBackend     string                       `json:"build_backend,omitempty"` // poetry, hatch, setuptools, pdm, flit, maturin, uv
+	Requirements     map[string]string            `json:"requirements,omitempty"`
+	RequirementFiles map[string]map[string]string `json:"requirement_files,omitempty"` // requirements-*.txt, requirements/*.txt
+	PyProject        map[string]any               `json:"pyproject,omitempty"`
+	Conda            *CondaEnv                    `json:"conda,omitempty"` // environment.yml
+
+	PythonVersion        string                       `json:"python_version,omitempty"` // .python-version
+	RequiresPython       string                       `json:"requires_python,omitempty"`
+	Dependencies         map[string]string            `json:"dependencies,omitempty"`          // nama -> constraint (PEP 621, Poetry, Pipfile, setup.cfg/setup.py)
+	OptionalDependencies map[string][]string          `json:"optional_dependencies,omitempty"` // extras
+	DependencyGroups     map[string]map[string]string `json:"dependency_groups,omitempty"`     // Poetry group, PEP 735, Pipfile dev-packages
+	Lock                 *LockInfo                    `json:"lock,omitempty"`                  // poetry.lock / uv.lock / pdm.lock / Pipfile.lock
+}
+
+type CondaEnv struct {
+	File         string            `json:"file"`
+	Name         string            `json:"name,omitempty"`
+	Channels     []string          `json:"channels,omitempty"`
+	Dependencies map[string]string `json:"dependencies,omitempty"`
+	Pip          map[string]string `json:"pip,omitempty"`
 }
 
 type RustInfo struct {
-	Package   string            `json:"package,omitempty"`
-	Edition   string            `json:"edition,omitempty"`
-	Deps      map[string]string `json:"deps,omitempty"`
-	Workspace bool              `json:"workspace"`
+	Package   string              `json:"package,omitempty"`
+	Edition   string              `json:"edition,omitempty"`
+	Deps      map[string]string   `json:"deps,omitempty"`
+	DevDeps   map[string]string   `json:"dev_deps,omitempty"`
+	BuildDeps map[string]string   `json:"build_deps,omitempty"`
+	Features  map[string][]string `json:"features,omitempty"`
+	Crates    []RustDep           `json:"crates,omitempty"` // detail setiap dependency
+	Workspace bool                `json:"workspace"`
+	Lock      *LockInfo           `json:"lock,omitempty"` // Cargo.lock
+}
+
+type RustDep struct {
+	Name      string   `json:"name"`
+	Kind      string   `json:"kind"` // normal, dev, build
+	Version   string   `json:"version,omitempty"`
+	Package   string   `json:"package,omitempty"` // nama crate asli bila di-rename
+	Path      string   `json:"path,omitempty"`
+	Git       string   `json:"git,omitempty"`
+	Ref       string   `json:"ref,omitempty"` // branch/tag/rev
+	Workspace bool     `json:"workspace,omitempty"`
+	Optional  bool     `json:"optional,omitempty"`
+	NoDefault bool     `json:"no_default_features,omitempty"`
+	Features  []string `json:"features,omitempty"`
+	Target    string   `json:"target,omitempty"` // [target.'cfg(..)'.dependencies]
 }
 
 type JavaInfo struct {
-	BuildTool string            `json:"build_tool,omitempty"`
-	GroupID   string            `json:"group_id,omitempty"`
-	Artifact  string            `json:"artifact,omitempty"`
-	Plugins   []string          `json:"plugins,omitempty"`
-	Deps      map[string]string `json:"deps,omitempty"`
+	BuildTool    string            `json:"build_tool,omitempty"`
+	GroupID      string            `json:"group_id,omitempty"`
+	Artifact     string            `json:"artifact,omitempty"`
+	Version      string            `json:"version,omitempty"`
+	Packaging    string            `json:"packaging,omitempty"`
+	Parent       *JavaCoord        `json:"parent,omitempty"`
+	Properties   map[string]string `json:"properties,omitempty"` // efektif (termasuk dari parent)
+	Modules      []string          `json:"modules,omitempty"`
+	Plugins      []string          `json:"plugins,omitempty"`
+	Deps         map[string]string `json:"deps,omitempty"` // group:artifact -> versi (resolved)
+	Dependencies []JavaDep         `json:"dependencies,omitempty"`
+	Managed      []JavaDep         `json:"managed,omitempty"` // <dependencyManagement> / platform()
+	Catalog      *GradleCatalog    `json:"catalog,omitempty"` // gradle/libs.versions.toml
+}
+
+type JavaCoord struct {
+	GroupID  string `json:"group_id"`
+	Artifact string `json:"artifact"`
+	Version  string `json:"version,omitempty"`
+}
+
+type JavaDep struct {
+	Group    string `json:"group"`
+	Artifact string `json:"artifact"`
+	Version  string `json:"version,omitempty"`
+	Scope    string `json:"scope,omitempty"` // Maven scope atau konfigurasi Gradle (implementation, testImplementation, ...)
+	Optional bool   `json:"optional,omitempty"`
+	Alias    string `json:"alias,omitempty"` // libs.<alias> dari version catalog
+}
+
+// GradleCatalog adalah isi version catalog; library & plugin sudah di-resolve ke versi.
+type GradleCatalog struct {
+	Versions  map[string]string   `json:"versions,omitempty"`
+	Libraries map[string]string   `json:"libraries,omitempty"` // alias -> group:artifact[:version]
+	Bundles   map[string][]string `json:"bundles,omitempty"`
+	Plugins   map[string]string   `json:"plugins,omitempty"` // alias -> id[:version]
 }
 
 type DotNetInfo struct {
-	Projects []DotNetProject `json:"projects,omitempty"`
+	Projects   []DotNetProject  `json:"projects,omitempty"`
+	Types      []CSharpType     `json:"types,omitempty"` // outline file .cs
+	DbContexts []DbContextInfo  `json:"db_contexts,omitempty"`
+	Services   []DIRegistration `json:"services,omitempty"`  // AddScoped<IFoo, Foo>() dkk.
+	Endpoints  []RouteEndpoint  `json:"endpoints,omitempty"` // controller + minimal API
+}
+
+// CSharpType adalah satu type (class/struct/interface/record/enum) di file .cs.
+type CSharpType struct {
+	Path       string         `json:"path"`
+	Namespace  string         `json:"namespace,omitempty"`
+	Kind       string         `json:"kind"`
+	Name       string         `json:"name"`
+	Line       int            `json:"line"`
+	Modifiers  []string       `json:"modifiers,omitempty"`
+	Attributes []string       `json:"attributes,omitempty"`
+	Extends    string         `json:"extends,omitempty"`
+	Implements []string       `json:"implements,omitempty"` // base list berawalan I
+	Members    []CSharpMember `json:"members,omitempty"`    // public saja
+
+	attrs []tsDecorator
+}
+
+type CSharpMember struct {
+	Name       string   `json:"name"`
+	Kind       string   `json:"kind"` // method, ctor, property, field, event
+	Signature  string   `json:"signature"`
+	Line       int      `json:"line"`
+	Attributes []string `json:"attributes,omitempty"`
+
+	attrs []tsDecorator
+}
+
+type DbContextInfo struct {
+	Name string         `json:"name"`
+	Path string         `json:"path"`
+	Sets []DbSetMapping `json:"sets,omitempty"`
+}
+
+type DbSetMapping struct {
+	Property string `json:"property"`
+	Entity   string `json:"entity"`
+}
+
+type DIRegistration struct {
+	Lifetime       string `json:"lifetime"` // scoped, transient, singleton, hosted, dbcontext, httpclient, options
+	Service        string `json:"service"`
+	Implementation string `json:"implementation,omitempty"`
+	File           string `json:"file"`
+	Line           int    `json:"line"`
 }
 
 type DotNetProject struct {
@@ -97,15 +346,46 @@ type RubyInfo struct {
 
 type DartInfo struct {
 	Name         string            `json:"name,omitempty"`
+	Version      string            `json:"version,omitempty"`
 	Flutter      bool              `json:"flutter,omitempty"`
+	Environment  map[string]string `json:"environment,omitempty"` // sdk, flutter
 	Dependencies map[string]string `json:"dependencies,omitempty"`
 	DevDeps      map[string]string `json:"dev_dependencies,omitempty"`
+	Overrides    map[string]string `json:"dependency_overrides,omitempty"`
+	Packages     []DartDep         `json:"packages,omitempty"` // detail sumber dependency
+	Assets       []string          `json:"assets,omitempty"`   // flutter.assets
+	Lock         *LockInfo         `json:"lock,omitempty"`     // pubspec.lock
+}
+
+type DartDep struct {
+	Name    string `json:"name"`
+	Kind    string `json:"kind"`   // main, dev, override
+	Source  string `json:"source"` // hosted, git, path, sdk
+	Version string `json:"version,omitempty"`
+	URL     string `json:"url,omitempty"` // git url / hosted url
+	Ref     string `json:"ref,omitempty"`
+	Path    string `json:"path,omitempty"` // path lokal atau path di dalam repo git
+	SDK     string `json:"sdk,omitempty"`
 }
 
 type SwiftInfo struct {
 	PackageName   string            `json:"package_name,omitempty"`
 	Deps          map[string]string `json:"deps,omitempty"`
 	UsesCocoaPods bool              `json:"uses_cocoapods,omitempty"`
+	Lock          *LockInfo         `json:"lock,omitempty"` // Package.resolved
+}
+
+// LockInfo berisi versi resolved dari satu lockfile.
+type LockInfo struct {
+	File     string      `json:"file"`
+	Packages []LockedDep `json:"packages,omitempty"`
+}
+
+type LockedDep struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Direct  bool   `json:"direct,omitempty"` // dideklarasikan langsung di manifest
+	Dev     bool   `json:"dev,omitempty"`
 }
 
 type CodeSummary struct {
@@ -115,25 +395,233 @@ type CodeSummary struct {
 }
 
 type LaravelCtx struct {
-	Controllers []PHPClassFile `json:"controllers,omitempty"`
-	Middleware  []PHPClassFile `json:"middleware,omitempty"`
-	Models      []PHPClassFile `json:"models,omitempty"`
-	Traits      []PHPClassFile `json:"traits,omitempty"`
-	Helpers     []PHPClassFile `json:"helpers,omitempty"`
+	Controllers   []PHPClassFile `json:"controllers,omitempty"`
+	Middleware    []PHPClassFile `json:"middleware,omitempty"`
+	Models        []PHPClassFile `json:"models,omitempty"`
+	Traits        []PHPClassFile `json:"traits,omitempty"`
+	Helpers       []PHPClassFile `json:"helpers,omitempty"`
+	Jobs          []PHPClassFile `json:"jobs,omitempty"`
+	Events        []PHPClassFile `json:"events,omitempty"`
+	Listeners     []PHPClassFile `json:"listeners,omitempty"`
+	Mail          []PHPClassFile `json:"mail,omitempty"`
+	Notifications []PHPClassFile `json:"notifications,omitempty"`
+	Policies      []PHPClassFile `json:"policies,omitempty"`
+	Requests      []PHPClassFile `json:"requests,omitempty"` // Form Requests
+	Resources     []PHPClassFile `json:"resources,omitempty"`
+	Commands      []PHPClassFile `json:"commands,omitempty"`
+	Providers     []PHPClassFile `json:"providers,omitempty"`
+	Observers     []PHPClassFile `json:"observers,omitempty"`
+	Livewire      []PHPClassFile `json:"livewire,omitempty"`
+	Filament      []PHPClassFile `json:"filament,omitempty"`
+
+	EventMap map[string][]string `json:"event_map,omitempty"` // event FQCN -> listener FQCN
+	Schedule []LaravelSchedule   `json:"schedule,omitempty"`
+
+	Config    []LaravelConfigKey  `json:"config,omitempty"`
+	ConfigEnv map[string][]string `json:"config_env,omitempty"` // env var -> config keys yang membacanya
+}
+
+// LaravelConfigKey adalah satu leaf di config/*.php, mis. database.connections.mysql.host.
+type LaravelConfigKey struct {
+	Key     string `json:"key"`
+	Env     string `json:"env,omitempty"`
+	Default string `json:"default,omitempty"` // default env(), kosong jika tanpa default
+	Value   string `json:"value,omitempty"`   // nilai literal jika tidak dari env()
+	File    string `json:"file"`
+}
+
+type LaravelSchedule struct {
+	Type      string `json:"type"` // command, job, call, exec
+	Target    string `json:"target,omitempty"`
+	Frequency string `json:"frequency,omitempty"` // chain setelah target, mis. dailyAt('02:00')->onOneServer()
+	Source    string `json:"source"`
 }
 
 type PHPClassFile struct {
-	Path      string   `json:"path"`
-	Namespace string   `json:"namespace,omitempty"`
-	Class     string   `json:"class,omitempty"`
-	Methods   []string `json:"methods,omitempty"`
+	Path       string        `json:"path"`
+	Namespace  string        `json:"namespace,omitempty"`
+	Imports    []string      `json:"imports,omitempty"` // file-level `use` (FQCN atau "FQCN as Alias")
+	Kind       string        `json:"kind,omitempty"`    // class, interface, trait, enum
+	Class      string        `json:"class,omitempty"`
+	Line       int           `json:"line,omitempty"`
+	Modifiers  []string      `json:"modifiers,omitempty"` // abstract, final, readonly
+	Extends    []string      `json:"extends,omitempty"`
+	Implements []string      `json:"implements,omitempty"`
+	Traits     []string      `json:"traits,omitempty"`
+	BackedType string        `json:"backed_type,omitempty"` // enum Foo: string
+	Cases      []string      `json:"cases,omitempty"`
+	Constants  []string      `json:"constants,omitempty"`
+	Properties []PHPProperty `json:"properties,omitempty"`
+	Methods    []PHPMethod   `json:"methods,omitempty"`
+
+	Rules       map[string][]string `json:"rules,omitempty"`       // Form Request rules()
+	Signature   string              `json:"signature,omitempty"`   // console command $signature
+	Description string              `json:"description,omitempty"` // console command $description
+
+	Eloquent *EloquentModel `json:"eloquent,omitempty"`
+}
+
+type EloquentModel struct {
+	Table         string             `json:"table"`
+	TableInferred bool               `json:"table_inferred,omitempty"` // dari nama class (konvensi)
+	PrimaryKey    string             `json:"primary_key,omitempty"`
+	Connection    string             `json:"connection,omitempty"`
+	Fillable      []string           `json:"fillable,omitempty"`
+	Guarded       []string           `json:"guarded,omitempty"`
+	Unguarded     bool               `json:"unguarded,omitempty"` // $guarded = []
+	Casts         map[string]string  `json:"casts,omitempty"`
+	Hidden        []string           `json:"hidden,omitempty"`
+	Appends       []string           `json:"appends,omitempty"`
+	SoftDeletes   bool               `json:"soft_deletes,omitempty"`
+	Relations     []EloquentRelation `json:"relations,omitempty"`
+	Scopes        []string           `json:"scopes,omitempty"`
+	Accessors     []string           `json:"accessors,omitempty"`
+	Mutators      []string           `json:"mutators,omitempty"`
+}
+
+type EloquentRelation struct {
+	Name    string   `json:"name"`
+	Type    string   `json:"type"` // hasMany, belongsTo, morphTo, ...
+	Related string   `json:"related,omitempty"`
+	Model   string   `json:"model,omitempty"` // path file model target (jika ada di scan)
+	Through string   `json:"through,omitempty"`
+	Pivot   string   `json:"pivot,omitempty"` // tabel pivot belongsToMany/morphToMany
+	Using   string   `json:"using,omitempty"` // custom pivot model
+	Morph   string   `json:"morph,omitempty"` // nama morph
+	Keys    []string `json:"keys,omitempty"`  // foreign/owner key eksplisit
+}
+
+type PHPProperty struct {
+	Name       string `json:"name"`
+	Visibility string `json:"visibility"`
+	Static     bool   `json:"static,omitempty"`
+	Readonly   bool   `json:"readonly,omitempty"`
+	Type       string `json:"type,omitempty"`
+	Default    string `json:"default,omitempty"`
+	Line       int    `json:"line,omitempty"`
+}
+
+type PHPMethod struct {
+	Name       string     `json:"name"`
+	Visibility string     `json:"visibility"`
+	Static     bool       `json:"static,omitempty"`
+	Abstract   bool       `json:"abstract,omitempty"`
+	Final      bool       `json:"final,omitempty"`
+	Params     []PHPParam `json:"params,omitempty"`
+	Returns    string     `json:"returns,omitempty"`
+	Line       int        `json:"line,omitempty"`
+
+	Request string              `json:"request,omitempty"` // Form Request yang di-type-hint
+	Rules   map[string][]string `json:"rules,omitempty"`   // $request->validate([...]) inline
+}
+
+type PHPParam struct {
+	Name     string `json:"name"`
+	Type     string `json:"type,omitempty"`
+	Default  string `json:"default,omitempty"`
+	ByRef    bool   `json:"by_ref,omitempty"`
+	Variadic bool   `json:"variadic,omitempty"`
+	Promoted string `json:"promoted,omitempty"` // visibility untuk constructor promotion
+}
+
+type DBSchema struct {
+	Source  string    `json:"source,omitempty"` // laravel, rails, sql
+	Tool    string    `json:"tool,omitempty"`   // golang-migrate, goose, flyway, liquibase, dbmate (sql)
+	Files   []string  `json:"files,omitempty"`  // file migration sesuai urutan replay
+	Tables  []DBTable `json:"tables,omitempty"`
+	Views   []DBView  `json:"views,omitempty"`
+	Mermaid string    `json:"mermaid,omitempty"` // erDiagram (opsional)
+}
+
+type DBView struct {
+	Name         string   `json:"name"`
+	Materialized bool     `json:"materialized,omitempty"`
+	Tables       []string `json:"tables,omitempty"` // tabel yang dibaca (FROM/JOIN)
+}
+
+type DBTable struct {
+	Name        string         `json:"name"`
+	Columns     []DBColumn     `json:"columns,omitempty"`
+	Indexes     []DBIndex      `json:"indexes,omitempty"`
+	ForeignKeys []DBForeignKey `json:"foreign_keys,omitempty"`
+}
+
+type DBColumn struct {
+	Name          string `json:"name"`
+	Type          string `json:"type"`
+	Nullable      bool   `json:"nullable,omitempty"`
+	Default       string `json:"default,omitempty"`
+	Unsigned      bool   `json:"unsigned,omitempty"`
+	AutoIncrement bool   `json:"auto_increment,omitempty"`
+	Primary       bool   `json:"primary,omitempty"`
+	Unique        bool   `json:"unique,omitempty"`
+}
+
+type DBIndex struct {
+	Name    string   `json:"name,omitempty"`
+	Columns []string `json:"columns"`
+	Unique  bool     `json:"unique,omitempty"`
+	Primary bool     `json:"primary,omitempty"`
+	Kind    string   `json:"kind,omitempty"` // fulltext, spatial
+}
+
+type DBForeignKey struct {
+	Name       string   `json:"name,omitempty"`
+	Columns    []string `json:"columns"`
+	RefTable   string   `json:"ref_table"`
+	RefColumns []string `json:"ref_columns,omitempty"`
+	OnDelete   string   `json:"on_delete,omitempty"`
+	OnUpdate   string   `json:"on_update,omitempty"`
 }
 
 type RouteFile struct {
-	Path    string            `json:"path"`
-	Snips   []string          `json:"snips"`
-	Guessed []string          `json:"guessed"`
-	Meta    map[string]string `json:"meta,omitempty"`
+	Path      string            `json:"path"`
+	Snips     []string          `json:"snips"`
+	Guessed   []string          `json:"guessed"`
+	Meta      map[string]string `json:"meta,omitempty"`
+	Endpoints []RouteEndpoint   `json:"endpoints,omitempty"`
+}
+
+type RouteEndpoint struct {
+	Method     string              `json:"method"`
+	Path       string              `json:"path"`
+	Name       string              `json:"name,omitempty"`
+	Controller string              `json:"controller,omitempty"` // FQCN
+	Action     string              `json:"action,omitempty"`
+	Request    string              `json:"request,omitempty"` // Form Request FQCN
+	Rules      map[string][]string `json:"rules,omitempty"`
+}
+
+type GraphQLInfo struct {
+	Files         []string       `json:"files,omitempty"`
+	Types         []GraphQLType  `json:"types,omitempty"`
+	Queries       []GraphQLField `json:"queries,omitempty"`
+	Mutations     []GraphQLField `json:"mutations,omitempty"`
+	Subscriptions []GraphQLField `json:"subscriptions,omitempty"`
+}
+
+type GraphQLType struct {
+	Name       string         `json:"name"`
+	Kind       string         `json:"kind"` // type, input, interface, enum, union, scalar
+	File       string         `json:"file,omitempty"`
+	Implements []string       `json:"implements,omitempty"`
+	Fields     []GraphQLField `json:"fields,omitempty"`
+	Values     []string       `json:"values,omitempty"` // enum values / union members
+}
+
+type GraphQLField struct {
+	Name       string       `json:"name"`
+	Type       string       `json:"type,omitempty"`
+	Args       []GraphQLArg `json:"args,omitempty"`
+	Directives []string     `json:"directives,omitempty"`
+	File       string       `json:"file,omitempty"`
+	Resolver   string       `json:"resolver,omitempty"`
+}
+
+type GraphQLArg struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Default string `json:"default,omitempty"`
 }
 
 type GitInfo struct {
@@ -173,3 +661,347 @@ type NDJSONFile struct {
 	SHA1    string `json:"sha1,omitempty"`
 	Content string `json:"content"`
 }
+
+// NDJSONSymbol adalah satu record index symbol.
+type NDJSONSymbol struct {
+	Type string `json:"type"`
+	Symbol
+}
+
+// ================= Django =================
+
+type DjangoInfo struct {
+	Settings      []string                  `json:"settings,omitempty"` // file settings yang terbaca
+	InstalledApps []string                  `json:"installed_apps,omitempty"`
+	Middleware    []string                  `json:"middleware,omitempty"`
+	Databases     map[string]DjangoDatabase `json:"databases,omitempty"`
+	AuthUserModel string                    `json:"auth_user_model,omitempty"`
+	RootURLConf   string                    `json:"root_urlconf,omitempty"`
+	Apps          []DjangoApp               `json:"apps,omitempty"`
+	Migrations    []DjangoMigration         `json:"migrations,omitempty"`
+}
+
+type DjangoDatabase struct {
+	Engine string `json:"engine,omitempty"`
+	Name   string `json:"name,omitempty"`
+	Host   string `json:"host,omitempty"`
+	Port   string `json:"port,omitempty"`
+	User   string `json:"user,omitempty"`
+	URL    string `json:"url,omitempty"` // dj_database_url / env.db()
+}
+
+type DjangoApp struct {
+	Label  string        `json:"label"`
+	Path   string        `json:"path"`
+	Name   string        `json:"name,omitempty"` // AppConfig.name
+	Models []DjangoModel `json:"models,omitempty"`
+	Views  []DjangoView  `json:"views,omitempty"`
+	Admin  []DjangoAdmin `json:"admin,omitempty"`
+}
+
+type DjangoModel struct {
+	Name     string            `json:"name"`
+	File     string            `json:"file"`
+	Line     int               `json:"line"`
+	Bases    []string          `json:"bases,omitempty"`
+	Abstract bool              `json:"abstract,omitempty"`
+	Table    string            `json:"table,omitempty"`
+	Fields   []DjangoField     `json:"fields,omitempty"`
+	Meta     map[string]string `json:"meta,omitempty"`
+	Managers []string          `json:"managers,omitempty"`
+}
+
+type DjangoField struct {
+	Name        string            `json:"name"`
+	Type        string            `json:"type"`
+	Related     string            `json:"related,omitempty"` // target ForeignKey/OneToOne/ManyToMany
+	OnDelete    string            `json:"on_delete,omitempty"`
+	RelatedName string            `json:"related_name,omitempty"`
+	Through     string            `json:"through,omitempty"`
+	Options     map[string]string `json:"options,omitempty"`
+}
+
+type DjangoView struct {
+	Name       string   `json:"name"`
+	Kind       string   `json:"kind"` // class, function
+	File       string   `json:"file"`
+	Line       int      `json:"line"`
+	Bases      []string `json:"bases,omitempty"`
+	Decorators []string `json:"decorators,omitempty"`
+	Methods    []string `json:"methods,omitempty"` // handler HTTP / action ViewSet
+	Model      string   `json:"model,omitempty"`
+	Serializer string   `json:"serializer,omitempty"`
+	Template   string   `json:"template,omitempty"`
+}
+
+type DjangoAdmin struct {
+	Model string `json:"model"`
+	Admin string `json:"admin,omitempty"` // class ModelAdmin, kosong = default
+	File  string `json:"file"`
+}
+
+type DjangoMigration struct {
+	App          string   `json:"app"`
+	Name         string   `json:"name"`
+	Initial      bool     `json:"initial,omitempty"`
+	Dependencies []string `json:"dependencies,omitempty"` // app.migration
+	Operations   []string `json:"operations,omitempty"`
+}
+
+// ================= Rails =================
+
+type RailsInfo struct {
+	Controllers []RubyClassFile `json:"controllers,omitempty"`
+	Models      []RubyClassFile `json:"models,omitempty"`
+	Jobs        []RubyClassFile `json:"jobs,omitempty"`
+	Mailers     []RubyClassFile `json:"mailers,omitempty"`
+	Concerns    []RubyClassFile `json:"concerns,omitempty"`
+}
+
+type RubyClassFile struct {
+	Path         string             `json:"path"`
+	Kind         string             `json:"kind"` // class, module
+	Name         string             `json:"name"` // termasuk namespace module, mis. Admin::UsersController
+	Superclass   string             `json:"superclass,omitempty"`
+	Line         int                `json:"line"`
+	Includes     []string           `json:"includes,omitempty"`
+	Methods      []string           `json:"methods,omitempty"` // public instance method (action untuk controller)
+	Callbacks    []string           `json:"callbacks,omitempty"`
+	Associations []RailsAssociation `json:"associations,omitempty"`
+	Validations  []string           `json:"validations,omitempty"`
+	Scopes       []string           `json:"scopes,omitempty"`
+	Enums        []string           `json:"enums,omitempty"`
+	Table        string             `json:"table,omitempty"`
+	QueueAs      string             `json:"queue_as,omitempty"`
+}
+
+type RailsAssociation struct {
+	Type    string            `json:"type"` // belongs_to, has_many, has_one, has_and_belongs_to_many
+	Name    string            `json:"name"`
+	Options map[string]string `json:"options,omitempty"`
+}
+
+// ================= ORM data models (Prisma/TypeORM/Sequelize/Drizzle) =================
+
+type DataModel struct {
+	Name      string         `json:"name"`
+	Kind      string         `json:"kind"`   // model, enum
+	Source    string         `json:"source"` // prisma, typeorm, sequelize, drizzle
+	File      string         `json:"file"`
+	Line      int            `json:"line,omitempty"`
+	Table     string         `json:"table,omitempty"`
+	Fields    []DataField    `json:"fields,omitempty"`
+	Relations []DataRelation `json:"relations,omitempty"`
+	Values    []string       `json:"values,omitempty"` // enum
+}
+
+type DataField struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Column   string `json:"column,omitempty"` // nama kolom jika beda dari field
+	Nullable bool   `json:"nullable,omitempty"`
+	List     bool   `json:"list,omitempty"`
+	Primary  bool   `json:"primary,omitempty"`
+	Unique   bool   `json:"unique,omitempty"`
+	Default  string `json:"default,omitempty"`
+}
+
+type DataRelation struct {
+	Name       string   `json:"name"`
+	Type       string   `json:"type"` // one-to-one, one-to-many, many-to-one, many-to-many
+	Target     string   `json:"target"`
+	Fields     []string `json:"fields,omitempty"`
+	References []string `json:"references,omitempty"`
+	Through    string   `json:"through,omitempty"`
+}
+
+// JSOutline merangkum module TS/JS: export, komponen React, hook, NestJS dan alias tsconfig.
+type JSOutline struct {
+	TSConfig string              `json:"tsconfig,omitempty"`
+	BaseURL  string              `json:"base_url,omitempty"`
+	Paths    map[string][]string `json:"paths,omitempty"` // compilerOptions.paths
+	Modules  []JSModule          `json:"modules,omitempty"`
+	Nest     *NestInfo           `json:"nest,omitempty"`
+}
+
+type JSModule struct {
+	Path        string     `json:"path"`
+	Exports     []JSExport `json:"exports,omitempty"`
+	Default     string     `json:"default,omitempty"` // nama/ekspresi export default
+	DefaultLine int        `json:"default_line,omitempty"`
+	ReExports   []string   `json:"re_exports,omitempty"` // export ... from 'x'
+	Components  []string   `json:"components,omitempty"` // komponen React
+	Hooks       []string   `json:"hooks,omitempty"`      // custom hook useXxx
+	Imports     []string   `json:"imports,omitempty"`    // specifier import/require apa adanya
+}
+
+type JSExport struct {
+	Name       string   `json:"name"`
+	Kind       string   `json:"kind"` // function, class, const, type, interface, enum
+	Line       int      `json:"line"`
+	Signature  string   `json:"signature,omitempty"`
+	Extends    string   `json:"extends,omitempty"`
+	Decorators []string `json:"decorators,omitempty"`
+}
+
+type NestInfo struct {
+	Modules     []NestModule `json:"modules,omitempty"`
+	Controllers []NestClass  `json:"controllers,omitempty"`
+	Providers   []NestClass  `json:"providers,omitempty"` // @Injectable, @Resolver, @WebSocketGateway
+}
+
+type NestModule struct {
+	Name        string   `json:"name"`
+	File        string   `json:"file"`
+	Line        int      `json:"line"`
+	Global      bool     `json:"global,omitempty"`
+	Imports     []string `json:"imports,omitempty"`
+	Controllers []string `json:"controllers,omitempty"`
+	Providers   []string `json:"providers,omitempty"`
+	Exports     []string `json:"exports,omitempty"`
+}
+
+type NestClass struct {
+	Name string `json:"name"`
+	Kind string `json:"kind,omitempty"`
+	Path string `json:"path,omitempty"` // prefix @Controller('x')
+	File string `json:"file"`
+	Line int    `json:"line"`
+}
+
+// PythonOutline merangkum module .py dan entry point dari packaging.
+type PythonOutline struct {
+	EntryPoints []PyEntryPoint `json:"entry_points,omitempty"`
+	Modules     []PyModule     `json:"modules,omitempty"`
+}
+
+type PyEntryPoint struct {
+	Name   string `json:"name"`
+	Target string `json:"target"`          // module:func
+	Group  string `json:"group,omitempty"` // console_scripts, gui_scripts, atau group plugin
+	Source string `json:"source"`          // pyproject.toml, setup.cfg, setup.py
+}
+
+type PyModule struct {
+	Path      string    `json:"path"`
+	Module    string    `json:"module"`
+	Doc       string    `json:"doc,omitempty"`
+	Main      bool      `json:"main,omitempty"` // punya `if __name__ == "__main__"`
+	Classes   []PyClass `json:"classes,omitempty"`
+	Functions []PyFunc  `json:"functions,omitempty"`
+}
+
+type PyClass struct {
+	Name       string   `json:"name"`
+	Bases      []string `json:"bases,omitempty"`
+	Line       int      `json:"line"`
+	Decorators []string `json:"decorators,omitempty"`
+	Doc        string   `json:"doc,omitempty"`
+	Methods    []PyFunc `json:"methods,omitempty"`
+}
+
+type PyFunc struct {
+	Name       string   `json:"name"`
+	Signature  string   `json:"signature"`
+	Line       int      `json:"line"`
+	Async      bool     `json:"async,omitempty"`
+	Decorators []string `json:"decorators,omitempty"`
+	Doc        string   `json:"doc,omitempty"`
+}
+
+// JavaClassFile adalah satu type top-level di file .java/.kt.
+type JavaClassFile struct {
+	Path        string       `json:"path"`
+	Lang        string       `json:"lang"` // java, kotlin
+	Package     string       `json:"package,omitempty"`
+	Kind        string       `json:"kind"` // class, interface, enum, record, annotation, object
+	Name        string       `json:"name"`
+	Line        int          `json:"line"`
+	Modifiers   []string     `json:"modifiers,omitempty"`
+	Annotations []string     `json:"annotations,omitempty"`
+	Extends     []string     `json:"extends,omitempty"`
+	Implements  []string     `json:"implements,omitempty"`
+	Stereotype  string       `json:"stereotype,omitempty"` // controller, service, repository, component, entity, configuration, application
+	Table       string       `json:"table,omitempty"`      // @Table(name=...)
+	Fields      []JavaField  `json:"fields,omitempty"`
+	Methods     []JavaMethod `json:"methods,omitempty"` // public saja
+
+	annos []tsDecorator
+}
+
+type JavaField struct {
+	Name        string   `json:"name"`
+	Type        string   `json:"type,omitempty"`
+	Line        int      `json:"line"`
+	Annotations []string `json:"annotations,omitempty"`
+}
+
+type JavaMethod struct {
+	Name        string   `json:"name"`
+	Signature   string   `json:"signature"`
+	Line        int      `json:"line"`
+	Annotations []string `json:"annotations,omitempty"`
+
+	annos []tsDecorator
+}
+
+// SpringCtx adalah inventaris komponen Spring (FQCN), setara LaravelCtx.
+type SpringCtx struct {
+	Application    string          `json:"application,omitempty"` // class @SpringBootApplication
+	Controllers    []string        `json:"controllers,omitempty"`
+	Services       []string        `json:"services,omitempty"`
+	Repositories   []string        `json:"repositories,omitempty"`
+	Components     []string        `json:"components,omitempty"`
+	Entities       []string        `json:"entities,omitempty"`
+	Configurations []string        `json:"configurations,omitempty"`
+	Beans          []string        `json:"beans,omitempty"` // Config#method dari @Bean
+	Endpoints      []RouteEndpoint `json:"endpoints,omitempty"`
+	Scheduled      []SpringTask    `json:"scheduled,omitempty"`
+	Listeners      []SpringTask    `json:"listeners,omitempty"` // @KafkaListener, @RabbitListener, @JmsListener, @EventListener
+}
+
+type SpringTask struct {
+	Kind    string `json:"kind"`    // cron, fixedRate, fixedDelay, kafka, rabbit, jms, event
+	Trigger string `json:"trigger"` // ekspresi cron/interval atau topic/queue
+	Class   string `json:"class"`
+	Method  string `json:"method"`
+	File    string `json:"file"`
+	Line    int    `json:"line"`
+}
+
+// DepGraph adalah graph import antar file project (dan agregasinya per direktori/package).
+type DepGraph struct {
+	Files    DepLevel `json:"files"`
+	Packages DepLevel `json:"packages"`
+}
+
+type DepLevel struct {
+	Nodes   []DepNode  `json:"nodes,omitempty"`
+	Edges   []DepEdge  `json:"edges,omitempty"`
+	Cycles  [][]string `json:"cycles,omitempty"`  // strongly connected component > 1 node
+	Central []string   `json:"central,omitempty"` // fan-in tertinggi
+	DOT     string     `json:"dot,omitempty"`
+	Mermaid string     `json:"mermaid,omitempty"`
+}
+
+type DepNode struct {
+	ID     string `json:"id"`
+	Lang   string `json:"lang,omitempty"`
+	FanIn  int    `json:"fan_in"`
+	FanOut int    `json:"fan_out"`
+}
+
+type DepEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// Symbol adalah satu definisi (type, fungsi, method, ...) dari outliner per bahasa.
+type Symbol struct {
+	Name      string `json:"name"`
+	Kind      string `json:"kind"`
+	File      string `json:"file"`
+	Line      int    `json:"line,omitempty"`
+	Container string `json:"container,omitempty"` // class/type/namespace induk
+	Lang      string `json:"lang"`
+}
@@ -13,6 +13,12 @@ var (
 	flagMaxSampleKB = flag.Int("max-sample-kb", 128, "max bytes per embedded sample")
 	flagMaxSamples  = flag.Int("max-samples", 50, "hard cap number of embedded samples")
 
+	// database schema
+	flagERDiagram = flag.Bool("er-diagram", false, "include Mermaid erDiagram text in database_schema")
+
+	// dependency graph
+	flagDepGraph = flag.String("dep-graph", "", "embed graph text in dependency_graph: dot or mermaid")
+
 	// route lines/snips
 	flagRouteLines = flag.Int("route-lines", 200, "max lines to scan per route file")
 
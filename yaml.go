@@ -0,0 +1,727 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ================= YAML =================
+//
+// Decoder YAML (subset 1.2 yang umum dipakai): block map/sequence, flow [..] {..},
+// scalar plain/quoted multi-baris, block scalar | >, anchor/alias, merge key <<,
+// tag (diabaikan kecuali !!str) dan multi-dokumen. Map menjadi map[string]any,
+// sequence []any, integer int64, float float64; .inf/.nan tetap string.
+
+var (
+	reYAMLInt   = regexp.MustCompile(`^[-+]?[0-9]+$`)
+	reYAMLFloat = regexp.MustCompile(`^[-+]?(\.[0-9]+|[0-9]+(\.[0-9]*)?)([eE][-+]?[0-9]+)?$`)
+)
+
+// readYAML membaca dokumen pertama sebagai map; kosong bila bukan map atau gagal dibaca.
+func readYAML(full string) map[string]any {
+	b, err := os.ReadFile(full)
+	if err != nil {
+		return map[string]any{}
+	}
+	docs, _ := parseYAML(string(b))
+	if len(docs) > 0 {
+		if m, ok := docs[0].(map[string]any); ok {
+			return m
+		}
+	}
+	return map[string]any{}
+}
+
+type yamlParser struct {
+	lines   []string
+	i       int
+	anchors map[string]any
+	err     error
+}
+
+// parseYAML mengembalikan semua dokumen (dipisah ---); error pertama dilaporkan, hasil parsial tetap ada.
+func parseYAML(src string) ([]any, error) {
+	var docs []any
+	var firstErr error
+	var cur []string
+	started := false
+	flush := func() {
+		if !started && strings.TrimSpace(strings.Join(cur, "")) == "" {
+			cur = nil
+			return
+		}
+		p := &yamlParser{lines: cur, anchors: map[string]any{}}
+		v := p.parseNode(0, false)
+		if j := p.next(); j >= 0 {
+			// sisa teks setelah node root (mis. dedent ke kolom yang tidak cocok)
+			p.i = j
+			p.fail("unexpected content")
+		}
+		if p.err != nil && firstErr == nil {
+			firstErr = p.err
+		}
+		docs = append(docs, v)
+		cur, started = nil, false
+	}
+	for _, l := range strings.Split(strings.TrimPrefix(src, "\ufeff"), "\n") {
+		l = strings.TrimRight(l, "\r")
+		switch {
+		case l == "---" || strings.HasPrefix(l, "--- ") || strings.HasPrefix(l, "---\t"):
+			flush()
+			started = true
+			// "--- |" atau "--- value": isi setelah marker ikut dokumen
+			if rest := yamlStripComment(strings.TrimSpace(l[3:])); rest != "" && !strings.HasPrefix(rest, "!") {
+				cur = append(cur, rest)
+			}
+		case l == "..." || strings.HasPrefix(l, "... "):
+			flush()
+		case strings.HasPrefix(l, "%") && len(cur) == 0:
+			// direktif %YAML / %TAG
+		default:
+			cur = append(cur, l)
+		}
+	}
+	flush()
+	return docs, firstErr
+}
+
+func (p *yamlParser) fail(format string, args ...any) {
+	if p.err == nil {
+		p.err = fmt.Errorf("yaml line %d: %s", p.i+1, fmt.Sprintf(format, args...))
+	}
+}
+
+func yamlIndent(l string) int { return len(l) - len(strings.TrimLeft(l, " ")) }
+
+// next melewati baris kosong/komentar dan mengembalikan index baris konten berikutnya, atau -1.
+func (p *yamlParser) next() int {
+	for j := p.i; j < len(p.lines); j++ {
+		t := strings.TrimSpace(p.lines[j])
+		if t != "" && !strings.HasPrefix(t, "#") {
+			return j
+		}
+	}
+	return -1
+}
+
+func yamlIsDash(c string) bool {
+	return c == "-" || strings.HasPrefix(c, "- ") || strings.HasPrefix(c, "-\t")
+}
+
+// parseNode mem-parse node yang dimulai di baris konten berikutnya bila indent-nya >= min.
+// sameSeq: sequence boleh sejajar dengan key induk ("key:\n- a").
+func (p *yamlParser) parseNode(min int, sameSeq bool) any {
+	j := p.next()
+	if j < 0 {
+		p.i = len(p.lines)
+		return nil
+	}
+	ind := yamlIndent(p.lines[j])
+	c := p.lines[j][ind:]
+	if ind < min && !(sameSeq && ind == min-1 && yamlIsDash(c)) {
+		return nil
+	}
+	p.i = j
+	return p.parseAt(ind)
+}
+
+// parseAt mem-parse node di baris p.i yang kontennya mulai di kolom ind.
+func (p *yamlParser) parseAt(ind int) any {
+	c := p.lines[p.i][ind:]
+	anchor, tag, rest := yamlProps(c)
+	if anchor != "" || tag != "" {
+		r := yamlStripComment(rest)
+		if r != "" && (yamlIsDash(r) || yamlKey(r)) {
+			// "&a key: v": properti milik map/sequence di kolom yang sama
+			col := len(p.lines[p.i]) - len(rest)
+			p.lines[p.i] = strings.Repeat(" ", col) + rest
+			v := p.parseAt(col)
+			p.anchor(anchor, v)
+			return v
+		}
+		return p.parseValue(c, ind-1, false)
+	}
+	switch {
+	case yamlIsDash(c):
+		return p.parseSeq(ind)
+	case yamlKey(c):
+		return p.parseMap(ind)
+	}
+	return p.parseValue(c, ind-1, false)
+}
+
+func (p *yamlParser) anchor(name string, v any) {
+	if name != "" {
+		p.anchors[name] = v
+	}
+}
+
+func (p *yamlParser) parseSeq(ind int) []any {
+	out := []any{}
+	for {
+		j := p.next()
+		if j < 0 || yamlIndent(p.lines[j]) != ind || !yamlIsDash(p.lines[j][ind:]) {
+			return out
+		}
+		p.i = j
+		item := p.lines[j][ind+1:]
+		rest := strings.TrimLeft(item, " \t")
+		col := ind + 1 + len(item) - len(rest)
+		r := yamlStripComment(rest)
+		_, _, afterProps := yamlProps(r)
+		if r != "" && (yamlIsDash(r) || yamlKey(r) || yamlKey(afterProps) && afterProps != r) {
+			// compact: "- key: v" atau "- - a" dibaca sebagai node di kolom isinya
+			p.lines[j] = strings.Repeat(" ", col) + rest
+			out = append(out, p.parseAt(col))
+			continue
+		}
+		out = append(out, p.parseValue(rest, ind, false))
+	}
+}
+
+func (p *yamlParser) parseMap(ind int) map[string]any {
+	out := map[string]any{}
+	var merges []any
+	for {
+		j := p.next()
+		if j < 0 || yamlIndent(p.lines[j]) != ind {
+			break
+		}
+		c := p.lines[j][ind:]
+		k, rest, ok := yamlSplitKey(c)
+		if !ok || yamlIsDash(c) {
+			if !yamlIsDash(c) {
+				p.i = j
+				p.fail("expected mapping key")
+			}
+			break
+		}
+		p.i = j
+		v := p.parseValue(rest, ind, true)
+		if k == "<<" {
+			merges = append(merges, v)
+			continue
+		}
+		if _, dup := out[k]; dup {
+			p.fail("duplicate key %q", k)
+		}
+		out[k] = v
+	}
+	// merge key: key eksplisit menang
+	for _, mv := range merges {
+		srcs, ok := mv.([]any)
+		if !ok {
+			srcs = []any{mv}
+		}
+		for _, s := range srcs {
+			if sm, ok := s.(map[string]any); ok {
+				for k, v := range sm {
+					if _, exists := out[k]; !exists {
+						out[k] = v
+					}
+				}
+			}
+		}
+	}
+	return out
+}
+
+// parseValue mem-parse nilai yang dimulai pada teks rest di baris p.i; parent = indent induk.
+func (p *yamlParser) parseValue(rest string, parent int, sameSeq bool) any {
+	anchor, tag, rest := yamlProps(strings.TrimSpace(rest))
+	r := yamlStripComment(rest)
+	var v any
+	switch {
+	case r == "":
+		p.i++
+		v = p.parseNode(parent+1, sameSeq)
+	case r[0] == '|' || r[0] == '>':
+		p.i++
+		v = p.blockScalar(r, parent)
+	case r[0] == '[' || r[0] == '{':
+		v = p.flowValue(rest)
+	case r[0] == '*':
+		p.i++
+		name := strings.TrimSpace(r[1:])
+		var ok bool
+		if v, ok = p.anchors[name]; !ok {
+			p.fail("unknown alias *%s", name)
+		}
+	case r[0] == '"' || r[0] == '\'':
+		v = p.quotedValue(rest)
+	default:
+		v = p.plainValue(r, parent, tag == "!!str")
+	}
+	if tag == "!!str" && v != nil {
+		if _, isStr := v.(string); !isStr {
+			v = fmt.Sprint(v)
+		}
+	}
+	p.anchor(anchor, v)
+	return v
+}
+
+// plainValue: scalar plain, boleh berlanjut di baris berikutnya yang lebih menjorok.
+// raw (tag !!str) mempertahankan teks apa adanya tanpa resolusi angka/bool.
+func (p *yamlParser) plainValue(first string, parent int, raw bool) any {
+	parts := []string{first}
+	for p.i++; p.i < len(p.lines); p.i++ {
+		l := p.lines[p.i]
+		t := strings.TrimSpace(l)
+		if t == "" || strings.HasPrefix(t, "#") || yamlIndent(l) <= parent {
+			break
+		}
+		parts = append(parts, yamlStripComment(t))
+	}
+	if raw {
+		return strings.Join(parts, " ")
+	}
+	return yamlResolve(strings.Join(parts, " "))
+}
+
+// quotedValue mengumpulkan string "..." / '...' yang bisa melewati beberapa baris.
+func (p *yamlParser) quotedValue(rest string) any {
+	text := rest
+	for {
+		if end := yamlQuoteEnd(text); end >= 0 {
+			p.i++
+			return yamlUnquote(text[:end+1])
+		}
+		p.i++
+		if p.i >= len(p.lines) {
+			p.fail("unterminated quoted scalar")
+			return yamlUnquote(text + text[:1])
+		}
+		// line folding: baris kosong = newline, selain itu spasi
+		t := strings.TrimSpace(p.lines[p.i])
+		if t == "" {
+			text += "\n"
+		} else if strings.HasSuffix(text, "\n") || strings.HasSuffix(text, "\\") && text[0] == '"' {
+			text = strings.TrimSuffix(text, "\\") + t
+		} else {
+			text += " " + t
+		}
+	}
+}
+
+// flowValue mengumpulkan koleksi flow [..]/{..} multi-baris lalu mem-parse-nya.
+func (p *yamlParser) flowValue(rest string) any {
+	text := yamlStripComment(rest)
+	for yamlFlowEnd(text) < 0 {
+		p.i++
+		if p.i >= len(p.lines) {
+			p.fail("unterminated flow collection")
+			break
+		}
+		text += " " + yamlStripComment(strings.TrimSpace(p.lines[p.i]))
+	}
+	p.i++
+	f := &yamlFlow{s: text, p: p}
+	return f.value()
+}
+
+// blockScalar: | (literal) atau > (folded) dengan chomping -/+ dan indikator indent.
+func (p *yamlParser) blockScalar(header string, parent int) any {
+	literal := header[0] == '|'
+	chomp, explicit := byte(0), 0
+	for _, c := range []byte(strings.TrimSpace(header[1:])) {
+		switch {
+		case c == '-' || c == '+':
+			chomp = c
+		case c >= '1' && c <= '9':
+			explicit = int(c - '0')
+		}
+	}
+	ind := -1
+	if explicit > 0 {
+		ind = max(parent, 0) + explicit
+	}
+	var body []string
+	for ; p.i < len(p.lines); p.i++ {
+		l := p.lines[p.i]
+		if strings.TrimSpace(l) == "" {
+			body = append(body, "")
+			continue
+		}
+		if ind < 0 {
+			ind = yamlIndent(l)
+		}
+		if yamlIndent(l) < ind || ind <= parent {
+			break
+		}
+		body = append(body, l[ind:])
+	}
+	trailing := 0
+	for len(body) > 0 && body[len(body)-1] == "" {
+		body = body[:len(body)-1]
+		trailing++
+	}
+	// baris kosong di akhir bukan milik scalar ini bila kita berhenti di node lain
+	var sb strings.Builder
+	more := func(s string) bool { return strings.HasPrefix(s, " ") || strings.HasPrefix(s, "\t") }
+	for k, l := range body {
+		if k > 0 {
+			prev := body[k-1]
+			switch {
+			case literal:
+				sb.WriteByte('\n')
+			case prev == "" || l == "":
+			case !more(prev) && !more(l):
+				sb.WriteByte(' ')
+			default:
+				sb.WriteByte('\n')
+			}
+		}
+		if !literal && l == "" {
+			sb.WriteByte('\n')
+			continue
+		}
+		sb.WriteString(l)
+	}
+	s := sb.String()
+	switch {
+	case chomp == '-' || len(body) == 0:
+	case chomp == '+':
+		s += strings.Repeat("\n", trailing+1)
+	default:
+		s += "\n"
+	}
+	return s
+}
+
+// ================= flow =================
+
+type yamlFlow struct {
+	s   string
+	pos int
+	p   *yamlParser
+}
+
+func (f *yamlFlow) ws() {
+	for f.pos < len(f.s) && (f.s[f.pos] == ' ' || f.s[f.pos] == '\t') {
+		f.pos++
+	}
+}
+
+func (f *yamlFlow) value() any {
+	f.ws()
+	if f.pos >= len(f.s) {
+		return nil
+	}
+	anchor := ""
+	for f.pos < len(f.s) && (f.s[f.pos] == '&' || f.s[f.pos] == '!') {
+		start := f.pos
+		for f.pos < len(f.s) && !strings.ContainsRune(" \t,]}", rune(f.s[f.pos])) {
+			f.pos++
+		}
+		if f.s[start] == '&' {
+			anchor = f.s[start+1 : f.pos]
+		}
+		f.ws()
+	}
+	var v any
+	switch c := f.s[f.pos]; c {
+	case '[':
+		f.pos++
+		arr := []any{}
+		for {
+			f.ws()
+			if f.pos >= len(f.s) || f.s[f.pos] == ']' {
+				f.pos++
+				break
+			}
+			item := f.value()
+			f.ws()
+			// pasangan tunggal di dalam sequence: [a: 1]
+			if f.pos < len(f.s) && f.s[f.pos] == ':' {
+				f.pos++
+				item = map[string]any{yamlKeyString(item): f.value()}
+				f.ws()
+			}
+			arr = append(arr, item)
+			if f.pos < len(f.s) && f.s[f.pos] == ',' {
+				f.pos++
+			}
+		}
+		v = arr
+	case '{':
+		f.pos++
+		m := map[string]any{}
+		for {
+			f.ws()
+			if f.pos >= len(f.s) || f.s[f.pos] == '}' {
+				f.pos++
+				break
+			}
+			k := yamlKeyString(f.value())
+			f.ws()
+			var val any
+			if f.pos < len(f.s) && f.s[f.pos] == ':' {
+				f.pos++
+				val = f.value()
+				f.ws()
+			}
+			m[k] = val
+			if f.pos < len(f.s) && f.s[f.pos] == ',' {
+				f.pos++
+			}
+		}
+		v = m
+	case '"', '\'':
+		end := yamlQuoteEnd(f.s[f.pos:])
+		if end < 0 {
+			end = len(f.s) - f.pos - 1
+		}
+		v = yamlUnquote(f.s[f.pos : f.pos+end+1])
+		f.pos += end + 1
+	case '*':
+		start := f.pos + 1
+		for f.pos < len(f.s) && !strings.ContainsRune(" \t,]}", rune(f.s[f.pos])) {
+			f.pos++
+		}
+		v = f.p.anchors[f.s[start:f.pos]]
+	default:
+		start := f.pos
+		for f.pos < len(f.s) {
+			c := f.s[f.pos]
+			if c == ',' || c == ']' || c == '}' {
+				break
+			}
+			if c == ':' && (f.pos+1 == len(f.s) || strings.ContainsRune(" \t,]}", rune(f.s[f.pos+1]))) {
+				break
+			}
+			f.pos++
+		}
+		v = yamlResolve(strings.TrimSpace(f.s[start:f.pos]))
+	}
+	f.p.anchor(anchor, v)
+	return v
+}
+
+// ================= scalar & helper =================
+
+// yamlStr mengubah scalar YAML (string/angka/bool) menjadi string; nil menjadi "".
+func yamlStr(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return ""
+}
+
+func yamlKeyString(k any) string {
+	if k == nil {
+		return ""
+	}
+	if s, ok := k.(string); ok {
+		return s
+	}
+	return fmt.Sprint(k)
+}
+
+// yamlResolve menerapkan core schema YAML 1.2 untuk scalar plain.
+func yamlResolve(s string) any {
+	switch s {
+	case "", "~", "null", "Null", "NULL":
+		return nil
+	case "true", "True", "TRUE":
+		return true
+	case "false", "False", "FALSE":
+		return false
+	}
+	if reYAMLInt.MatchString(s) {
+		if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return n
+		}
+		return s
+	}
+	if len(s) > 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'o') {
+		base := 16
+		if s[1] == 'o' {
+			base = 8
+		}
+		if n, err := strconv.ParseInt(s[2:], base, 64); err == nil {
+			return n
+		}
+	}
+	if reYAMLFloat.MatchString(s) {
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return f
+		}
+	}
+	return s
+}
+
+// yamlProps memisahkan anchor (&a) dan tag (!t) di awal teks.
+func yamlProps(s string) (anchor, tag, rest string) {
+	rest = s
+	for len(rest) > 0 && (rest[0] == '&' || rest[0] == '!') {
+		end := strings.IndexAny(rest, " \t")
+		if end < 0 {
+			end = len(rest)
+		}
+		if rest[0] == '&' {
+			anchor = rest[1:end]
+		} else {
+			tag = rest[:end]
+		}
+		rest = strings.TrimLeft(rest[end:], " \t")
+	}
+	return anchor, tag, rest
+}
+
+func yamlKey(c string) bool {
+	_, _, ok := yamlSplitKey(c)
+	return ok
+}
+
+// yamlSplitKey memecah "key: rest" (key plain atau quoted) di luar string/flow.
+func yamlSplitKey(c string) (key, rest string, ok bool) {
+	if c == "" || c[0] == '#' || c[0] == '[' || c[0] == '{' || yamlIsDash(c) {
+		return "", "", false
+	}
+	if c[0] == '"' || c[0] == '\'' {
+		end := yamlQuoteEnd(c)
+		if end < 0 {
+			return "", "", false
+		}
+		after := strings.TrimLeft(c[end+1:], " \t")
+		if after == ":" || strings.HasPrefix(after, ": ") || strings.HasPrefix(after, ":\t") {
+			return yamlKeyString(yamlUnquote(c[:end+1])), after[1:], true
+		}
+		return "", "", false
+	}
+	if strings.HasPrefix(c, "? ") {
+		return "", "", false
+	}
+	for i := 0; i < len(c); i++ {
+		switch c[i] {
+		case '#':
+			if i > 0 && (c[i-1] == ' ' || c[i-1] == '\t') {
+				return "", "", false
+			}
+		case ':':
+			if i+1 == len(c) || c[i+1] == ' ' || c[i+1] == '\t' {
+				return strings.TrimSpace(c[:i]), c[i+1:], true
+			}
+		}
+	}
+	return "", "", false
+}
+
+// yamlQuoteEnd mengembalikan index kutip penutup untuk s[0], atau -1.
+func yamlQuoteEnd(s string) int {
+	q := s[0]
+	for i := 1; i < len(s); i++ {
+		switch {
+		case q == '"' && s[i] == '\\':
+			i++
+		case q == '\'' && s[i] == '\'' && i+1 < len(s) && s[i+1] == '\'':
+			i++
+		case s[i] == q:
+			return i
+		}
+	}
+	return -1
+}
+
+func yamlUnquote(s string) string {
+	body := s[1 : len(s)-1]
+	if s[0] == '\'' {
+		return strings.ReplaceAll(body, "''", "'")
+	}
+	var sb strings.Builder
+	for i := 0; i < len(body); i++ {
+		c := body[i]
+		if c != '\\' || i+1 >= len(body) {
+			sb.WriteByte(c)
+			continue
+		}
+		i++
+		switch e := body[i]; e {
+		case 'n':
+			sb.WriteByte('\n')
+		case 't':
+			sb.WriteByte('\t')
+		case 'r':
+			sb.WriteByte('\r')
+		case '0':
+			sb.WriteByte(0)
+		case 'e':
+			sb.WriteByte(0x1b)
+		case ' ', '"', '\\', '/':
+			sb.WriteByte(e)
+		case 'x', 'u', 'U':
+			n := map[byte]int{'x': 2, 'u': 4, 'U': 8}[e]
+			if i+n < len(body)+1 {
+				if r, err := strconv.ParseUint(body[i+1:min(i+1+n, len(body))], 16, 32); err == nil {
+					sb.WriteRune(rune(r))
+					i += n
+					continue
+				}
+			}
+			sb.WriteByte(e)
+		default:
+			sb.WriteByte('\\')
+			sb.WriteByte(e)
+		}
+	}
+	return sb.String()
+}
+
+// yamlStripComment membuang " # komentar" di luar string.
+func yamlStripComment(s string) string {
+	var q byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case q != 0:
+			if q == '"' && c == '\\' {
+				i++
+			} else if c == q {
+				q = 0
+			}
+		case (c == '"' || c == '\'') && (i == 0 || strings.ContainsRune(" \t[{,:", rune(s[i-1]))):
+			q = c
+		case c == '#' && (i == 0 || s[i-1] == ' ' || s[i-1] == '\t'):
+			return strings.TrimRight(s[:i], " \t")
+		}
+	}
+	return strings.TrimRight(s, " \t")
+}
+
+// yamlFlowEnd mengembalikan index penutup koleksi flow yang dibuka di s[0], atau -1.
+func yamlFlowEnd(s string) int {
+	depth := 0
+	var q byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case q != 0:
+			if q == '"' && c == '\\' {
+				i++
+			} else if c == q {
+				q = 0
+			}
+		case (c == '"' || c == '\'') && (i == 0 || strings.ContainsRune(" \t[{,:", rune(s[i-1]))):
+			q = c
+		case c == '[' || c == '{':
+			depth++
+		case c == ']' || c == '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestParseYAML(t *testing.T) {
+	cases := []struct {
+		name string
+		src  string
+		want string // JSON dari semua dokumen
+		err  string // substring error; kosong = harus sukses
+	}{
+		{
+			name: "block map and sequence",
+			src:  "name: app\nversion: 1.2\nlist:\n  - a\n  - 2\n  - true\n  - null\nnested:\n  k: v\n",
+			want: `[{"list":["a",2,true,null],"name":"app","nested":{"k":"v"},"version":1.2}]`,
+		},
+		{
+			name: "sequence at parent indent",
+			src:  "deps:\n- a\n- b\nnext: 1\n",
+			want: `[{"deps":["a","b"],"next":1}]`,
+		},
+		{
+			name: "compact nested sequence of maps",
+			src:  "items:\n  - name: a\n    port: 80\n  - name: b\n    tags: [x, y]\n",
+			want: `[{"items":[{"name":"a","port":80},{"name":"b","tags":["x","y"]}]}]`,
+		},
+		{
+			name: "flow collections",
+			src:  "a: [1, \"two\", {k: v, n: [x]}]\nb: {x: 1, 'y': [], z: {}}\nc: [\n  multi,\n  line\n]\n",
+			want: `[{"a":[1,"two",{"k":"v","n":["x"]}],"b":{"x":1,"y":[],"z":{}},"c":["multi","line"]}]`,
+		},
+		{
+			name: "anchors, aliases and merge keys",
+			src:  "base: &base\n  image: node\n  env: dev\nsvc:\n  <<: *base\n  env: prod\nlist: &l [1, 2]\nref: *l\n",
+			want: `[{"base":{"env":"dev","image":"node"},"list":[1,2],"ref":[1,2],"svc":{"env":"prod","image":"node"}}]`,
+		},
+		{
+			name: "merge list of maps",
+			src:  "a: &a {x: 1}\nb: &b {y: 2}\nc:\n  <<: [*a, *b]\n",
+			want: `[{"a":{"x":1},"b":{"y":2},"c":{"x":1,"y":2}}]`,
+		},
+		{
+			name: "block scalars",
+			src:  "lit: |\n  line1\n  line2\nfold: >\n  a\n  b\n\n  c\nstrip: |-\n  x\nkeep: |+\n  y\n\nafter: z\n",
+			want: `[{"after":"z","fold":"a b\nc\n","keep":"y\n\n","lit":"line1\nline2\n","strip":"x"}]`,
+		},
+		{
+			name: "quoted scalars and comments",
+			src:  "a: \"x # not comment\" # comment\nb: 'it''s'\nc: \"esc\\n\\t\\\"q\\\"\"\nd: plain # trailing\n",
+			want: `[{"a":"x # not comment","b":"it's","c":"esc\n\t\"q\"","d":"plain"}]`,
+		},
+		{
+			name: "multi-document",
+			src:  "---\nkind: A\n---\nkind: B\n...\n---\n- 1\n",
+			want: `[{"kind":"A"},{"kind":"B"},[1]]`,
+		},
+		{
+			name: "trailing blank line does not add a document",
+			src:  "a: 1\n---\nb: 2\n\n",
+			want: `[{"a":1},{"b":2}]`,
+		},
+		{
+			name: "str tag keeps text",
+			src:  "v: !!str 1.10\nw: '1.10'\n",
+			want: `[{"v":"1.10","w":"1.10"}]`,
+		},
+		{
+			name: "dedent to an unmatched column",
+			src:  "a:\n  b: 1\n c: 2\n",
+			err:  "unexpected content",
+		},
+		{
+			name: "mapping after root sequence",
+			src:  "- a\nb: 1\n",
+			err:  "unexpected content",
+		},
+		{
+			name: "duplicate key",
+			src:  "a: 1\nb: 2\na: 3\n",
+			err:  `duplicate key "a"`,
+		},
+		{
+			name: "unknown alias",
+			src:  "a: *missing\n",
+			err:  "unknown alias",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			docs, err := parseYAML(tc.src)
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("err = %v, want %q", err, tc.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got, _ := json.Marshal(docs)
+			if string(got) != tc.want {
+				t.Errorf("got  %s\nwant %s", got, tc.want)
+			}
+		})
+	}
+}